@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -97,6 +98,7 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	ibcporttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/spf13/cast"
@@ -209,7 +211,7 @@ var (
 		ibctransfertypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:                     nil,
 		wasm.ModuleName:                         {authtypes.Burner},
-		interchainqueriesmoduletypes.ModuleName: nil,
+		interchainqueriesmoduletypes.ModuleName: {authtypes.Burner},
 	}
 )
 
@@ -467,6 +469,13 @@ func New(
 		&app.WasmKeeper,
 		app.BankKeeper,
 	)
+	// queries over a localhost client are served from the stores of the chain itself
+	localStoreKeys := make(map[string]storetypes.StoreKey, len(keys))
+	for name, key := range keys {
+		localStoreKeys[name] = key
+	}
+	app.InterchainQueriesKeeper.RegisterProofVerifier(ibcexported.Localhost,
+		interchainqueriesmodulekeeper.NewLocalhostProofVerifier(app.IBCKeeper, localStoreKeys))
 	app.InterchainTxsKeeper = *interchaintxskeeper.NewKeeper(
 		appCodec,
 		keys[interchaintxstypes.StoreKey],
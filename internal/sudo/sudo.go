@@ -30,10 +30,31 @@ type MessageTxQueryResult struct {
 // was submitted for a kv-query.
 type MessageKVQueryResult struct {
 	KVQueryResult struct {
-		QueryID uint64 `json:"query_id"`
+		QueryID  uint64 `json:"query_id"`
+		Height   uint64 `json:"height"`
+		Revision uint64 `json:"revision"`
 	} `json:"kv_query_result"`
 }
 
+// MessageQuerySuspended is passed to a contract's sudo() entrypoint when a query of the contract
+// was suspended because the IBC client of the query connection is frozen or expired.
+type MessageQuerySuspended struct {
+	QuerySuspended struct {
+		QueryID      uint64 `json:"query_id"`
+		ClientID     string `json:"client_id"`
+		ClientStatus string `json:"client_status"`
+	} `json:"query_suspended"`
+}
+
+// MessageQueryResumed is passed to a contract's sudo() entrypoint when a suspended query of the contract
+// was resumed because the IBC client of the query connection became active again.
+type MessageQueryResumed struct {
+	QueryResumed struct {
+		QueryID  uint64 `json:"query_id"`
+		ClientID string `json:"client_id"`
+	} `json:"query_resumed"`
+}
+
 // MessageTimeout is passed to a contract's sudo() entrypoint when an interchain
 // transaction failed with a timeout.
 type MessageTimeout struct {
@@ -222,8 +243,8 @@ func (s *Handler) SudoOnChanOpenAck(
 
 // SudoTxQueryResult is used to pass a tx query result to the contract that registered the query
 // to:
-// 		1. check whether the transaction actually satisfies the initial query arguments;
-// 		2. execute business logic related to the tx query result / save the result to state.
+//  1. check whether the transaction actually satisfies the initial query arguments;
+//  2. execute business logic related to the tx query result / save the result to state.
 func (s *Handler) SudoTxQueryResult(
 	ctx sdk.Context,
 	contractAddress sdk.AccAddress,
@@ -238,16 +259,11 @@ func (s *Handler) SudoTxQueryResult(
 		return nil, fmt.Errorf("%s is not a contract address", contractAddress)
 	}
 
-	x := MessageTxQueryResult{}
-	x.TxQueryResult.QueryID = queryID
-	x.TxQueryResult.Height = uint64(height)
-	x.TxQueryResult.Data = data
-
-	m, err := json.Marshal(x)
+	m, err := NewTxQueryResultMessage(queryID, height, data)
 	if err != nil {
 		s.Logger(ctx).Error("SudoTxQueryResult: failed to marshal MessageTxQueryResult message",
 			"error", err, "contract_address", contractAddress)
-		return nil, fmt.Errorf("failed to marshal MessageTxQueryResult: %v", err)
+		return nil, err
 	}
 
 	resp, err := s.wasmKeeper.Sudo(ctx, contractAddress, m)
@@ -261,11 +277,13 @@ func (s *Handler) SudoTxQueryResult(
 }
 
 // SudoKVQueryResult is used to pass a kv query id to the contract that registered the query
-// when a query result is provided by the relayer.
+// when a query result obtained at the remote height and revision is provided by the relayer.
 func (s *Handler) SudoKVQueryResult(
 	ctx sdk.Context,
 	contractAddress sdk.AccAddress,
 	queryID uint64,
+	height uint64,
+	revision uint64,
 ) ([]byte, error) {
 	s.Logger(ctx).Info("SudoKVQueryResult", "contractAddress", contractAddress)
 
@@ -274,19 +292,104 @@ func (s *Handler) SudoKVQueryResult(
 		return nil, fmt.Errorf("%s is not a contract address", contractAddress)
 	}
 
+	m, err := NewKVQueryResultMessage(queryID, height, revision)
+	if err != nil {
+		s.Logger(ctx).Error("SudoKVQueryResult: failed to marshal MessageKVQueryResult message",
+			"error", err, "contract_address", contractAddress)
+		return nil, err
+	}
+
+	resp, err := s.wasmKeeper.Sudo(ctx, contractAddress, m)
+	if err != nil {
+		s.Logger(ctx).Debug("SudoKVQueryResult: failed to Sudo",
+			"error", err, "contract_address", contractAddress)
+		return nil, fmt.Errorf("failed to Sudo: %v", err)
+	}
+
+	return resp, nil
+}
+
+// NewTxQueryResultMessage returns the JSON message passed to the contract that registered a tx query
+// when a transaction is submitted for the query.
+func NewTxQueryResultMessage(queryID uint64, height int64, data []byte) ([]byte, error) {
+	x := MessageTxQueryResult{}
+	x.TxQueryResult.QueryID = queryID
+	x.TxQueryResult.Height = uint64(height)
+	x.TxQueryResult.Data = data
+
+	m, err := json.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageTxQueryResult: %v", err)
+	}
+
+	return m, nil
+}
+
+// NewKVQueryResultMessage returns the JSON message passed to the contract that registered a kv query
+// when a query result obtained at the remote height and revision is provided by the relayer.
+func NewKVQueryResultMessage(queryID uint64, height uint64, revision uint64) ([]byte, error) {
 	x := MessageKVQueryResult{}
 	x.KVQueryResult.QueryID = queryID
+	x.KVQueryResult.Height = height
+	x.KVQueryResult.Revision = revision
 
 	m, err := json.Marshal(x)
 	if err != nil {
-		s.Logger(ctx).Error("SudoKVQueryResult: failed to marshal MessageKVQueryResult message",
-			"error", err, "contract_address", contractAddress)
 		return nil, fmt.Errorf("failed to marshal MessageKVQueryResult: %v", err)
 	}
 
-	resp, err := s.wasmKeeper.Sudo(ctx, contractAddress, m)
+	return m, nil
+}
+
+// NewQuerySuspendedMessage returns the JSON message passed to the contract that registered a query
+// when the query is suspended because the IBC client of the query connection has the clientStatus.
+func NewQuerySuspendedMessage(queryID uint64, clientID string, clientStatus string) ([]byte, error) {
+	x := MessageQuerySuspended{}
+	x.QuerySuspended.QueryID = queryID
+	x.QuerySuspended.ClientID = clientID
+	x.QuerySuspended.ClientStatus = clientStatus
+
+	m, err := json.Marshal(x)
 	if err != nil {
-		s.Logger(ctx).Debug("SudoKVQueryResult: failed to Sudo",
+		return nil, fmt.Errorf("failed to marshal MessageQuerySuspended: %v", err)
+	}
+
+	return m, nil
+}
+
+// NewQueryResumedMessage returns the JSON message passed to the contract that registered a query
+// when the suspended query is resumed because the IBC client of the query connection is active again.
+func NewQueryResumedMessage(queryID uint64, clientID string) ([]byte, error) {
+	x := MessageQueryResumed{}
+	x.QueryResumed.QueryID = queryID
+	x.QueryResumed.ClientID = clientID
+
+	m, err := json.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageQueryResumed: %v", err)
+	}
+
+	return m, nil
+}
+
+// Sudo passes the already built JSON message to the contract's sudo() entrypoint. It is used to pass
+// the interchain query results recorded after a failed SudoKVQueryResult or SudoTxQueryResult call again,
+// and the query status messages built with NewQuerySuspendedMessage and NewQueryResumedMessage.
+func (s *Handler) Sudo(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	msg []byte,
+) ([]byte, error) {
+	s.Logger(ctx).Debug("Sudo", "contractAddress", contractAddress)
+
+	if !s.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		s.Logger(ctx).Debug("Sudo: contract not found", "contractAddress", contractAddress)
+		return nil, fmt.Errorf("%s is not a contract address", contractAddress)
+	}
+
+	resp, err := s.wasmKeeper.Sudo(ctx, contractAddress, msg)
+	if err != nil {
+		s.Logger(ctx).Debug("Sudo: failed to Sudo",
 			"error", err, "contract_address", contractAddress)
 		return nil, fmt.Errorf("failed to Sudo: %v", err)
 	}
@@ -1,6 +1,10 @@
 package bindings
 
-import "github.com/neutron-org/neutron/x/interchainqueries/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
 
 // ProtobufAny is a hack-struct to serialize protobuf Any message into JSON object
 type ProtobufAny struct {
@@ -12,11 +16,14 @@ type ProtobufAny struct {
 // Follow https://github.com/neutron-org/neutron-contracts/tree/main/packages/bindings/src/msg.rs
 // for more information.
 type NeutronMsg struct {
-	SubmitTx                  *SubmitTx                  `json:"submit_tx,omitempty"`
-	RegisterInterchainAccount *RegisterInterchainAccount `json:"register_interchain_account,omitempty"`
-	RegisterInterchainQuery   *RegisterInterchainQuery   `json:"register_interchain_query,omitempty"`
-	UpdateInterchainQuery     *UpdateInterchainQuery     `json:"update_interchain_query,omitempty"`
-	RemoveInterchainQuery     *RemoveInterchainQuery     `json:"remove_interchain_query,omitempty"`
+	SubmitTx                         *SubmitTx                         `json:"submit_tx,omitempty"`
+	RegisterInterchainAccount        *RegisterInterchainAccount        `json:"register_interchain_account,omitempty"`
+	RegisterInterchainQuery          *RegisterInterchainQuery          `json:"register_interchain_query,omitempty"`
+	UpdateInterchainQuery            *UpdateInterchainQuery            `json:"update_interchain_query,omitempty"`
+	RemoveInterchainQuery            *RemoveInterchainQuery            `json:"remove_interchain_query,omitempty"`
+	FundQueryReward                  *FundQueryReward                  `json:"fund_query_reward,omitempty"`
+	RetrySudoFailure                 *RetrySudoFailure                 `json:"retry_sudo_failure,omitempty"`
+	TransferInterchainQueryOwnership *TransferInterchainQueryOwnership `json:"transfer_interchain_query_ownership,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -52,6 +59,9 @@ type RegisterInterchainQuery struct {
 	TransactionsFilter string         `json:"transactions_filter"`
 	ConnectionId       string         `json:"connection_id"`
 	UpdatePeriod       uint64         `json:"update_period"`
+	SubmissionReward   sdk.Coins      `json:"submission_reward,omitempty"`
+	ResultHistorySize  uint64         `json:"result_history_size,omitempty"`
+	TxMessagesFilter   string         `json:"tx_messages_filter,omitempty"`
 }
 
 // RegisterInterchainQueryResponse holds response for RegisterInterchainQuery
@@ -67,10 +77,39 @@ type RemoveInterchainQueryResponse struct {
 }
 
 type UpdateInterchainQuery struct {
-	QueryId         uint64         `json:"query_id,omitempty"`
-	NewKeys         []*types.KVKey `json:"new_keys,omitempty"`
-	NewUpdatePeriod uint64         `json:"new_update_period,omitempty"`
+	QueryId               uint64         `json:"query_id,omitempty"`
+	NewKeys               []*types.KVKey `json:"new_keys,omitempty"`
+	NewUpdatePeriod       uint64         `json:"new_update_period,omitempty"`
+	NewTransactionsFilter string         `json:"new_transactions_filter,omitempty"`
+	NewConnectionId       string         `json:"new_connection_id,omitempty"`
 }
 
 type UpdateInterchainQueryResponse struct {
 }
+
+// FundQueryReward adds funds to the reward escrow of an interchain query.
+type FundQueryReward struct {
+	QueryId uint64    `json:"query_id"`
+	Amount  sdk.Coins `json:"amount"`
+}
+
+type FundQueryRewardResponse struct {
+}
+
+// RetrySudoFailure passes the query result of a recorded sudo failure to the contract sudo handler again.
+type RetrySudoFailure struct {
+	QueryId   uint64 `json:"query_id"`
+	FailureId uint64 `json:"failure_id"`
+}
+
+type RetrySudoFailureResponse struct {
+}
+
+// TransferInterchainQueryOwnership makes another contract the owner of an interchain query.
+type TransferInterchainQueryOwnership struct {
+	QueryId  uint64 `json:"query_id"`
+	NewOwner string `json:"new_owner"`
+}
+
+type TransferInterchainQueryOwnershipResponse struct {
+}
@@ -18,12 +18,98 @@ type NeutronQuery struct {
 	RegisteredInterchainQueries *QueryRegisteredQueriesRequest `json:"registered_interchain_queries,omitempty"`
 	/// RegisteredInterchainQuery
 	RegisteredInterchainQuery *QueryRegisteredQueryRequest `json:"registered_interchain_query,omitempty"`
+	/// Results kept in the result history of a KV Interchain Query for specified QueryID and remote heights range
+	InterchainQueryResultHistory *QueryResultHistoryRequest `json:"interchain_query_result_history,omitempty"`
+	/// KV key of a well-known Cosmos SDK or IBC store to register a KV Interchain Query with
+	InterchainQueryKVKey *QueryKVKeyRequest `json:"interchain_query_kv_key,omitempty"`
+	/// Registered Interchain Query Result for specified QueryID with the values of well-known keys decoded into JSON
+	InterchainQueryDecodedResult *QueryRegisteredQueryResultRequest `json:"interchain_query_decoded_result,omitempty"`
+	/// Deposit collected on registration of an Interchain Query of the given type and amount of keys
+	InterchainQueryDepositEstimate *QueryDepositEstimateRequest `json:"interchain_query_deposit_estimate,omitempty"`
 }
 
 /* Requests */
 
 type QueryRegisteredQueryResultRequest struct {
 	QueryId uint64 `json:"query_id,omitempty"`
+	// MaxAgeBlocks is the maximum amount of local blocks passed since the result submission; zero value means no limit
+	MaxAgeBlocks uint64 `json:"max_age_blocks,omitempty"`
+	// MaxAgeSeconds is the maximum amount of seconds passed since the remote block time of the result; zero value means no limit
+	MaxAgeSeconds uint64 `json:"max_age_seconds,omitempty"`
+}
+
+type QueryResultHistoryRequest struct {
+	QueryId uint64 `json:"query_id,omitempty"`
+	// MinHeight is the lowest remote height of the returned results, inclusive
+	MinHeight uint64 `json:"min_height,omitempty"`
+	// MaxHeight is the highest remote height of the returned results, inclusive; zero value means no upper bound
+	MaxHeight uint64 `json:"max_height,omitempty"`
+	// Revision is the remote revision the heights of the range belong to
+	Revision uint64 `json:"revision,omitempty"`
+}
+
+type QueryDepositEstimateRequest struct {
+	QueryType string `json:"query_type"`
+	KeysCount uint64 `json:"keys_count,omitempty"`
+}
+
+// QueryKVKeyRequest describes the KV key to build. Exactly one of the fields must be set.
+type QueryKVKeyRequest struct {
+	BankBalance                            *BankBalanceKey           `json:"bank_balance,omitempty"`
+	BankSupply                             *BankSupplyKey            `json:"bank_supply,omitempty"`
+	StakingValidator                       *ValidatorKey             `json:"staking_validator,omitempty"`
+	StakingDelegation                      *DelegationKey            `json:"staking_delegation,omitempty"`
+	StakingUnbondingDelegation             *DelegationKey            `json:"staking_unbonding_delegation,omitempty"`
+	DistributionFeePool                    *struct{}                 `json:"distribution_fee_pool,omitempty"`
+	DistributionDelegatorWithdrawAddress   *DelegatorKey             `json:"distribution_delegator_withdraw_address,omitempty"`
+	DistributionValidatorOutstandingReward *ValidatorKey             `json:"distribution_validator_outstanding_rewards,omitempty"`
+	DistributionDelegatorStartingInfo      *DelegationKey            `json:"distribution_delegator_starting_info,omitempty"`
+	GovProposal                            *GovProposalKey           `json:"gov_proposal,omitempty"`
+	GovVote                                *GovProposalVoterKey      `json:"gov_vote,omitempty"`
+	GovDeposit                             *GovProposalDepositorKey  `json:"gov_deposit,omitempty"`
+	IBCTransferDenomTrace                  *IBCTransferDenomTraceKey `json:"ibc_transfer_denom_trace,omitempty"`
+}
+
+type BankBalanceKey struct {
+	Address string `json:"address"`
+	Denom   string `json:"denom"`
+}
+
+type BankSupplyKey struct {
+	Denom string `json:"denom"`
+}
+
+type ValidatorKey struct {
+	// Validator is the operator address of the validator on the remote chain
+	Validator string `json:"validator"`
+}
+
+type DelegatorKey struct {
+	Delegator string `json:"delegator"`
+}
+
+type DelegationKey struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+}
+
+type GovProposalKey struct {
+	ProposalId uint64 `json:"proposal_id"`
+}
+
+type GovProposalVoterKey struct {
+	ProposalId uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+}
+
+type GovProposalDepositorKey struct {
+	ProposalId uint64 `json:"proposal_id"`
+	Depositor  string `json:"depositor"`
+}
+
+type IBCTransferDenomTraceKey struct {
+	// Denom is either the hex hash of the denom trace or the full IBC denom, i.e. 'ibc/{hash}'
+	Denom string `json:"denom"`
 }
 
 type QueryInterchainAccountAddressRequest struct {
@@ -52,7 +138,8 @@ type QueryRegisteredQueryResponse struct {
 }
 
 type QueryRegisteredQueriesResponse struct {
-	RegisteredQueries []RegisteredQuery `json:"registered_queries"`
+	RegisteredQueries []RegisteredQuery   `json:"registered_queries"`
+	Pagination        *query.PageResponse `json:"pagination,omitempty"`
 }
 
 type RegisteredQuery struct {
@@ -74,10 +161,24 @@ type RegisteredQuery struct {
 	LastSubmittedResultLocalHeight uint64 `json:"last_submitted_result_local_height"`
 	// The remote chain last block height when the query result was updated.
 	LastSubmittedResultRemoteHeight uint64 `json:"last_submitted_result_remote_height"`
+	// The revision of the remote chain when the query result was updated.
+	LastSubmittedResultRevision uint64 `json:"last_submitted_result_revision"`
 	// Amount of coins deposited for the query.
 	QueryDeposit sdktypes.Coins `json:"query_deposit"`
 	// Timeout before query becomes available for everybody to remove.
 	SubmitTimeout uint64 `json:"submit_timeout"`
+	// Amount of coins paid to a relayer for every successfully submitted query result.
+	SubmissionReward sdktypes.Coins `json:"submission_reward"`
+	// Amount of coins escrowed to pay submission rewards to relayers.
+	RewardEscrow sdktypes.Coins `json:"reward_escrow"`
+	// The amount of the last submitted results kept in the query result history.
+	ResultHistorySize uint64 `json:"result_history_size"`
+	// The structured filter the messages of the submitted transactions are verified against.
+	TxMessagesFilter string `json:"tx_messages_filter"`
+	// The query is suspended because the IBC client of its connection is frozen or expired.
+	Suspended bool `json:"suspended"`
+	// The Tendermint tx search queries the transactions filter of a TX query is rendered to.
+	TendermintQueries []string `json:"tendermint_queries,omitempty"`
 }
 
 func (rq RegisteredQuery) MarshalJSON() ([]byte, error) {
@@ -106,6 +207,46 @@ type QueryInterchainAccountAddressResponse struct {
 
 type QueryRegisteredQueryResultResponse struct {
 	Result *QueryResult `json:"result,omitempty"`
+	// LastSubmittedResultLocalHeight is the local height the result has been submitted at
+	LastSubmittedResultLocalHeight uint64 `json:"last_submitted_result_local_height"`
+	// RemoteBlockTime is the remote block time of the result in nanoseconds taken from the consensus state the result
+	// is verified against; zero value means the time isn't known anymore
+	RemoteBlockTime uint64 `json:"remote_block_time,omitempty"`
+}
+
+type QueryResultHistoryResponse struct {
+	Results []QueryResult `json:"results"`
+}
+
+type QueryKVKeyResponse struct {
+	KVKey types.KVKey `json:"kv_key"`
+}
+
+type QueryDepositEstimateResponse struct {
+	Deposit sdktypes.Coins `json:"deposit"`
+}
+
+type QueryDecodedQueryResultResponse struct {
+	Result                         *DecodedQueryResult `json:"result,omitempty"`
+	LastSubmittedResultLocalHeight uint64              `json:"last_submitted_result_local_height"`
+	RemoteBlockTime                uint64              `json:"remote_block_time,omitempty"`
+}
+
+type DecodedQueryResult struct {
+	KvResults []DecodedStorageValue `json:"kv_results"`
+	Height    uint64                `json:"height,omitempty"`
+	Revision  uint64                `json:"revision,omitempty"`
+}
+
+type DecodedStorageValue struct {
+	StoragePrefix string `json:"storage_prefix,omitempty"`
+	Key           []byte `json:"key"`
+	// Type is the full protobuf name of the decoded value type; empty if the value is unknown
+	Type string `json:"type,omitempty"`
+	// Decoded is the value decoded into JSON; empty if the value is unknown
+	Decoded json.RawMessage `json:"decoded,omitempty"`
+	// Value is the raw value; set only if the value is unknown
+	Value []byte `json:"value,omitempty"`
 }
 
 type QueryResult struct {
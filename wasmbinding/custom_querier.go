@@ -20,9 +20,7 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 		switch {
 		case contractQuery.InterchainQueryResult != nil:
-			queryID := contractQuery.InterchainQueryResult.QueryId
-
-			response, err := qp.GetInterchainQueryResult(ctx, queryID)
+			response, err := qp.GetInterchainQueryResult(ctx, contractQuery.InterchainQueryResult)
 			if err != nil {
 				return nil, sdkerrors.Wrapf(err, "failed to get interchain query result: %v", err)
 			}
@@ -69,6 +67,54 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				return nil, sdkerrors.Wrapf(err, "failed to marshal interchain account query response: %v", err)
 			}
 
+			return bz, nil
+		case contractQuery.InterchainQueryResultHistory != nil:
+			history, err := qp.GetInterchainQueryResultHistory(ctx, contractQuery.InterchainQueryResultHistory)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to get interchain query result history: %v", err)
+			}
+
+			bz, err := json.Marshal(history)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal interchain query result history: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.InterchainQueryDecodedResult != nil:
+			response, err := qp.GetInterchainQueryDecodedResult(ctx, contractQuery.InterchainQueryDecodedResult)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to get decoded interchain query result: %v", err)
+			}
+
+			bz, err := json.Marshal(response)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal decoded interchain query result: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.InterchainQueryDepositEstimate != nil:
+			estimate, err := qp.GetInterchainQueryDepositEstimate(ctx, contractQuery.InterchainQueryDepositEstimate)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to get interchain query deposit estimate: %v", err)
+			}
+
+			bz, err := json.Marshal(estimate)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal interchain query deposit estimate: %v", err)
+			}
+
+			return bz, nil
+		case contractQuery.InterchainQueryKVKey != nil:
+			kvKey, err := qp.GetInterchainQueryKVKey(ctx, contractQuery.InterchainQueryKVKey)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to build kv key: %v", err)
+			}
+
+			bz, err := json.Marshal(kvKey)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to marshal kv key: %v", err)
+			}
+
 			return bz, nil
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron query type"}
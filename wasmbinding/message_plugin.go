@@ -63,6 +63,15 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.RemoveInterchainQuery != nil {
 			return m.removeInterchainQuery(ctx, contractAddr, contractMsg.RemoveInterchainQuery)
 		}
+		if contractMsg.FundQueryReward != nil {
+			return m.fundQueryReward(ctx, contractAddr, contractMsg.FundQueryReward)
+		}
+		if contractMsg.RetrySudoFailure != nil {
+			return m.retrySudoFailure(ctx, contractAddr, contractMsg.RetrySudoFailure)
+		}
+		if contractMsg.TransferInterchainQueryOwnership != nil {
+			return m.transferInterchainQueryOwnership(ctx, contractAddr, contractMsg.TransferInterchainQueryOwnership)
+		}
 	}
 
 	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
@@ -98,10 +107,12 @@ func (m *CustomMessenger) updateInterchainQuery(ctx sdk.Context, contractAddr sd
 
 func (m *CustomMessenger) performUpdateInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, updateQuery *bindings.UpdateInterchainQuery) (*bindings.UpdateInterchainQueryResponse, error) {
 	msg := icqtypes.MsgUpdateInterchainQueryRequest{
-		QueryId:         updateQuery.QueryId,
-		NewKeys:         updateQuery.NewKeys,
-		NewUpdatePeriod: updateQuery.NewUpdatePeriod,
-		Sender:          contractAddr.String(),
+		QueryId:               updateQuery.QueryId,
+		NewKeys:               updateQuery.NewKeys,
+		NewUpdatePeriod:       updateQuery.NewUpdatePeriod,
+		NewTransactionsFilter: updateQuery.NewTransactionsFilter,
+		NewConnectionId:       updateQuery.NewConnectionId,
+		Sender:                contractAddr.String(),
 	}
 
 	if err := msg.ValidateBasic(); err != nil {
@@ -162,6 +173,147 @@ func (m *CustomMessenger) performRemoveInterchainQuery(ctx sdk.Context, contract
 	return (*bindings.RemoveInterchainQueryResponse)(response), nil
 }
 
+func (m *CustomMessenger) fundQueryReward(ctx sdk.Context, contractAddr sdk.AccAddress, fundReward *bindings.FundQueryReward) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performFundQueryReward(ctx, contractAddr, fundReward)
+	if err != nil {
+		ctx.Logger().Debug("performFundQueryReward: failed to fund interchain query reward",
+			"from_address", contractAddr.String(),
+			"msg", fundReward,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to fund interchain query reward")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal FundQueryRewardResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", fundReward,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("interchain query reward funded",
+		"from_address", contractAddr.String(),
+		"msg", fundReward,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performFundQueryReward(ctx sdk.Context, contractAddr sdk.AccAddress, fundReward *bindings.FundQueryReward) (*bindings.FundQueryRewardResponse, error) {
+	msg := icqtypes.MsgFundQueryReward{
+		QueryId: fundReward.QueryId,
+		Amount:  fundReward.Amount,
+		Sender:  contractAddr.String(),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to validate incoming FundQueryReward message")
+	}
+
+	response, err := m.Icqmsgserver.FundQueryReward(sdk.WrapSDKContext(ctx), &msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to fund interchain query reward")
+	}
+
+	return (*bindings.FundQueryRewardResponse)(response), nil
+}
+
+func (m *CustomMessenger) retrySudoFailure(ctx sdk.Context, contractAddr sdk.AccAddress, retry *bindings.RetrySudoFailure) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performRetrySudoFailure(ctx, contractAddr, retry)
+	if err != nil {
+		ctx.Logger().Debug("performRetrySudoFailure: failed to retry sudo failure",
+			"from_address", contractAddr.String(),
+			"msg", retry,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to retry sudo failure")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal RetrySudoFailureResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", retry,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("sudo failure retried",
+		"from_address", contractAddr.String(),
+		"msg", retry,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performRetrySudoFailure(ctx sdk.Context, contractAddr sdk.AccAddress, retry *bindings.RetrySudoFailure) (*bindings.RetrySudoFailureResponse, error) {
+	msg := icqtypes.MsgRetrySudoFailure{
+		QueryId:   retry.QueryId,
+		FailureId: retry.FailureId,
+		Sender:    contractAddr.String(),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to validate incoming RetrySudoFailure message")
+	}
+
+	response, err := m.Icqmsgserver.RetrySudoFailure(sdk.WrapSDKContext(ctx), &msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to retry sudo failure")
+	}
+
+	return (*bindings.RetrySudoFailureResponse)(response), nil
+}
+
+func (m *CustomMessenger) transferInterchainQueryOwnership(ctx sdk.Context, contractAddr sdk.AccAddress, transfer *bindings.TransferInterchainQueryOwnership) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performTransferInterchainQueryOwnership(ctx, contractAddr, transfer)
+	if err != nil {
+		ctx.Logger().Debug("performTransferInterchainQueryOwnership: failed to transfer interchain query ownership",
+			"from_address", contractAddr.String(),
+			"msg", transfer,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "failed to transfer interchain query ownership")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal TransferInterchainQueryOwnershipResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", transfer,
+			"error", err,
+		)
+		return nil, nil, sdkerrors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("interchain query ownership transferred",
+		"from_address", contractAddr.String(),
+		"msg", transfer,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performTransferInterchainQueryOwnership(ctx sdk.Context, contractAddr sdk.AccAddress, transfer *bindings.TransferInterchainQueryOwnership) (*bindings.TransferInterchainQueryOwnershipResponse, error) {
+	msg := icqtypes.MsgTransferInterchainQueryOwnership{
+		QueryId:  transfer.QueryId,
+		NewOwner: transfer.NewOwner,
+		Sender:   contractAddr.String(),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to validate incoming TransferInterchainQueryOwnership message")
+	}
+
+	response, err := m.Icqmsgserver.TransferInterchainQueryOwnership(sdk.WrapSDKContext(ctx), &msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to transfer interchain query ownership")
+	}
+
+	return (*bindings.TransferInterchainQueryOwnershipResponse)(response), nil
+}
+
 func (m *CustomMessenger) submitTx(ctx sdk.Context, contractAddr sdk.AccAddress, submitTx *bindings.SubmitTx) ([]sdk.Event, [][]byte, error) {
 	response, err := m.PerformSubmitTx(ctx, contractAddr, submitTx)
 	if err != nil {
@@ -320,6 +472,9 @@ func (m *CustomMessenger) PerformRegisterInterchainQuery(ctx sdk.Context, contra
 		ConnectionId:       reg.ConnectionId,
 		UpdatePeriod:       reg.UpdatePeriod,
 		Sender:             contractAddr.String(),
+		SubmissionReward:   reg.SubmissionReward,
+		ResultHistorySize:  reg.ResultHistorySize,
+		TxMessagesFilter:   reg.TxMessagesFilter,
 	}
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to validate incoming RegisterInterchainQuery message")
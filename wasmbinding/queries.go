@@ -1,35 +1,243 @@
 package wasmbinding
 
 import (
+	"encoding/json"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
 	"github.com/neutron-org/neutron/wasmbinding/bindings"
 	"github.com/neutron-org/neutron/x/interchainqueries/types"
 	icatypes "github.com/neutron-org/neutron/x/interchaintxs/types"
 )
 
-func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, queryID uint64) (*bindings.QueryRegisteredQueryResultResponse, error) {
-	grpcResp, err := qp.icqKeeper.GetQueryResultByID(ctx, queryID)
+// GetInterchainQueryResult returns the last submitted result of the query along with the data telling how old it is.
+// The result exceeding the max age set in the request is rejected with the types.ErrStaleQueryResult error.
+func (qp *QueryPlugin) GetInterchainQueryResult(ctx sdk.Context, req *bindings.QueryRegisteredQueryResultRequest) (*bindings.QueryRegisteredQueryResultResponse, error) {
+	grpcResp, err := qp.icqKeeper.GetQueryResultByID(ctx, req.QueryId)
+	if err != nil {
+		return nil, err
+	}
+
+	localHeight, remoteTime, err := qp.checkQueryResultAge(ctx, req, ibcclienttypes.NewHeight(grpcResp.GetRevision(), grpcResp.GetHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapGRPCQueryResultToWasmBindings(grpcResp)
+
+	return &bindings.QueryRegisteredQueryResultResponse{
+		Result:                         &resp,
+		LastSubmittedResultLocalHeight: localHeight,
+		RemoteBlockTime:                remoteTime,
+	}, nil
+}
+
+func (qp *QueryPlugin) GetInterchainQueryDecodedResult(ctx sdk.Context, req *bindings.QueryRegisteredQueryResultRequest) (*bindings.QueryDecodedQueryResultResponse, error) {
+	grpcResp, err := qp.icqKeeper.DecodedQueryResult(sdk.WrapSDKContext(ctx), &types.QueryRegisteredQueryResultRequest{QueryId: req.QueryId})
 	if err != nil {
 		return nil, err
 	}
-	resp := bindings.QueryResult{
-		KvResults: make([]*bindings.StorageValue, 0, len(grpcResp.KvResults)),
+
+	localHeight, remoteTime, err := qp.checkQueryResultAge(ctx, req, ibcclienttypes.NewHeight(grpcResp.GetRevision(), grpcResp.GetHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := bindings.DecodedQueryResult{
+		KvResults: make([]bindings.DecodedStorageValue, 0, len(grpcResp.GetKvResults())),
 		Height:    grpcResp.GetHeight(),
 		Revision:  grpcResp.GetRevision(),
 	}
 	for _, grpcKv := range grpcResp.GetKvResults() {
+		kv := bindings.DecodedStorageValue{
+			StoragePrefix: grpcKv.GetStoragePrefix(),
+			Key:           grpcKv.GetKey(),
+			Type:          grpcKv.GetType(),
+			Value:         grpcKv.GetValue(),
+		}
+		if grpcKv.GetDecodedJson() != "" {
+			kv.Decoded = json.RawMessage(grpcKv.GetDecodedJson())
+		}
+		result.KvResults = append(result.KvResults, kv)
+	}
+
+	return &bindings.QueryDecodedQueryResultResponse{
+		Result:                         &result,
+		LastSubmittedResultLocalHeight: localHeight,
+		RemoteBlockTime:                remoteTime,
+	}, nil
+}
+
+// checkQueryResultAge returns the local height the last result of the query has been submitted at and the remote
+// block time of the result, and checks them against the max age set in the request. The remote block time is zero
+// if it isn't known anymore, so the result is considered stale if the request limits its age in seconds. Once the
+// request limits the age at all, the result obtained at the height is also stale if it has been obtained over the
+// previous connection of the query or at the previous revision of the remote chain. The results are returned with
+// no checks to the requests without age limits. For localhost clients the remote block time is the local block time.
+func (qp *QueryPlugin) checkQueryResultAge(ctx sdk.Context, req *bindings.QueryRegisteredQueryResultRequest, height ibcclienttypes.Height) (uint64, uint64, error) {
+	query, err := qp.icqKeeper.GetQueryByID(ctx, req.QueryId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if req.MaxAgeBlocks != 0 || req.MaxAgeSeconds != 0 {
+		if err := qp.icqKeeper.CheckLastResultCurrent(ctx, query, height); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	localHeight := query.GetLastSubmittedResultLocalHeight()
+	if req.MaxAgeBlocks != 0 {
+		if age := uint64(ctx.BlockHeight()) - localHeight; age > req.MaxAgeBlocks {
+			return 0, 0, sdkerrors.Wrapf(types.ErrStaleQueryResult, "result of query %d is %d blocks old, max age is %d blocks",
+				req.QueryId, age, req.MaxAgeBlocks)
+		}
+	}
+
+	remoteTime, err := qp.icqKeeper.GetLastResultRemoteTimestamp(ctx, query)
+	if err != nil {
+		ctx.Logger().Debug("checkQueryResultAge: failed to get remote block time", "query_id", req.QueryId, "error", err)
+		remoteTime = 0
+	}
+
+	if req.MaxAgeSeconds != 0 {
+		if remoteTime == 0 {
+			return 0, 0, sdkerrors.Wrapf(types.ErrStaleQueryResult, "remote block time of the result of query %d is unknown", req.QueryId)
+		}
+
+		blockTime := uint64(ctx.BlockTime().UnixNano())
+		if blockTime > remoteTime {
+			if age := time.Duration(blockTime - remoteTime); age > time.Duration(req.MaxAgeSeconds)*time.Second {
+				return 0, 0, sdkerrors.Wrapf(types.ErrStaleQueryResult, "result of query %d is %s old, max age is %d seconds",
+					req.QueryId, age, req.MaxAgeSeconds)
+			}
+		}
+	}
+
+	return localHeight, remoteTime, nil
+}
+
+func (qp *QueryPlugin) GetInterchainQueryDepositEstimate(ctx sdk.Context, req *bindings.QueryDepositEstimateRequest) (*bindings.QueryDepositEstimateResponse, error) {
+	grpcResp, err := qp.icqKeeper.DepositEstimate(sdk.WrapSDKContext(ctx), &types.QueryDepositEstimateRequest{
+		QueryType: req.QueryType,
+		KeysCount: req.KeysCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.QueryDepositEstimateResponse{Deposit: grpcResp.GetDeposit()}, nil
+}
+
+func (qp *QueryPlugin) GetInterchainQueryResultHistory(ctx sdk.Context, req *bindings.QueryResultHistoryRequest) (*bindings.QueryResultHistoryResponse, error) {
+	grpcResp, err := qp.icqKeeper.QueryResultHistory(sdk.WrapSDKContext(ctx), &types.QueryResultHistoryRequest{
+		QueryId:   req.QueryId,
+		MinHeight: req.MinHeight,
+		MaxHeight: req.MaxHeight,
+		Revision:  req.Revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := bindings.QueryResultHistoryResponse{Results: make([]bindings.QueryResult, 0, len(grpcResp.GetResults()))}
+	for i := range grpcResp.GetResults() {
+		resp.Results = append(resp.Results, mapGRPCQueryResultToWasmBindings(&grpcResp.Results[i]))
+	}
+
+	return &resp, nil
+}
+
+// GetInterchainQueryKVKey builds the KV key described by the request using the typed KV key builders.
+func (qp *QueryPlugin) GetInterchainQueryKVKey(_ sdk.Context, req *bindings.QueryKVKeyRequest) (*bindings.QueryKVKeyResponse, error) {
+	var (
+		kvKey types.KVKey
+		err   error
+		set   int
+	)
+
+	if r := req.BankBalance; r != nil {
+		set++
+		kvKey, err = types.NewBankBalanceKVKey(r.Address, r.Denom)
+	}
+	if r := req.BankSupply; r != nil {
+		set++
+		kvKey, err = types.NewBankSupplyKVKey(r.Denom)
+	}
+	if r := req.StakingValidator; r != nil {
+		set++
+		kvKey, err = types.NewStakingValidatorKVKey(r.Validator)
+	}
+	if r := req.StakingDelegation; r != nil {
+		set++
+		kvKey, err = types.NewStakingDelegationKVKey(r.Delegator, r.Validator)
+	}
+	if r := req.StakingUnbondingDelegation; r != nil {
+		set++
+		kvKey, err = types.NewStakingUnbondingDelegationKVKey(r.Delegator, r.Validator)
+	}
+	if req.DistributionFeePool != nil {
+		set++
+		kvKey = types.NewDistributionFeePoolKVKey()
+	}
+	if r := req.DistributionDelegatorWithdrawAddress; r != nil {
+		set++
+		kvKey, err = types.NewDistributionDelegatorWithdrawAddressKVKey(r.Delegator)
+	}
+	if r := req.DistributionValidatorOutstandingReward; r != nil {
+		set++
+		kvKey, err = types.NewDistributionValidatorOutstandingRewardsKVKey(r.Validator)
+	}
+	if r := req.DistributionDelegatorStartingInfo; r != nil {
+		set++
+		kvKey, err = types.NewDistributionDelegatorStartingInfoKVKey(r.Delegator, r.Validator)
+	}
+	if r := req.GovProposal; r != nil {
+		set++
+		kvKey = types.NewGovProposalKVKey(r.ProposalId)
+	}
+	if r := req.GovVote; r != nil {
+		set++
+		kvKey, err = types.NewGovVoteKVKey(r.ProposalId, r.Voter)
+	}
+	if r := req.GovDeposit; r != nil {
+		set++
+		kvKey, err = types.NewGovDepositKVKey(r.ProposalId, r.Depositor)
+	}
+	if r := req.IBCTransferDenomTrace; r != nil {
+		set++
+		kvKey, err = types.NewIBCTransferDenomTraceKVKey(r.Denom)
+	}
+
+	if set != 1 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidKVKeyParams, "exactly one kv key type must be set, got %d", set)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.QueryKVKeyResponse{KVKey: kvKey}, nil
+}
+
+func mapGRPCQueryResultToWasmBindings(grpcResult *types.QueryResult) bindings.QueryResult {
+	result := bindings.QueryResult{
+		KvResults: make([]*bindings.StorageValue, 0, len(grpcResult.KvResults)),
+		Height:    grpcResult.GetHeight(),
+		Revision:  grpcResult.GetRevision(),
+	}
+	for _, grpcKv := range grpcResult.GetKvResults() {
 		kv := bindings.StorageValue{
 			StoragePrefix: grpcKv.GetStoragePrefix(),
 			Key:           grpcKv.GetKey(),
 			Value:         grpcKv.GetValue(),
 		}
-		resp.KvResults = append(resp.KvResults, &kv)
+		result.KvResults = append(result.KvResults, &kv)
 	}
 
-	return &bindings.QueryRegisteredQueryResultResponse{Result: &resp}, nil
+	return result
 }
 
 func (qp *QueryPlugin) GetInterchainAccountAddress(ctx sdk.Context, req *bindings.QueryInterchainAccountAddressRequest) (*bindings.QueryInterchainAccountAddressResponse, error) {
@@ -52,21 +260,21 @@ func (qp *QueryPlugin) GetRegisteredInterchainQueries(ctx sdk.Context, query *bi
 	grpcResp, err := qp.icqKeeper.GetRegisteredQueries(ctx, &types.QueryRegisteredQueriesRequest{
 		Owners:       query.Owners,
 		ConnectionId: query.ConnectionId,
-		Pagination: &sdkquery.PageRequest{
-			Key:        query.Pagination.Key,
-			Offset:     query.Pagination.Offset,
-			Limit:      query.Pagination.Limit,
-			CountTotal: query.Pagination.CountTotal,
-			Reverse:    query.Pagination.Reverse,
-		},
+		Pagination:   query.Pagination,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp := bindings.QueryRegisteredQueriesResponse{RegisteredQueries: make([]bindings.RegisteredQuery, 0, len(grpcResp.GetRegisteredQueries()))}
+	resp := bindings.QueryRegisteredQueriesResponse{
+		RegisteredQueries: make([]bindings.RegisteredQuery, 0, len(grpcResp.GetRegisteredQueries())),
+		Pagination:        grpcResp.GetPagination(),
+	}
 	for _, grpcQuery := range grpcResp.GetRegisteredQueries() {
-		query := mapGRPCRegisteredQueryToWasmBindings(grpcQuery)
+		query, err := mapGRPCRegisteredQueryToWasmBindings(grpcQuery)
+		if err != nil {
+			return nil, err
+		}
 		resp.RegisteredQueries = append(resp.RegisteredQueries, query)
 	}
 	return &resp, nil
@@ -80,12 +288,20 @@ func (qp *QueryPlugin) GetRegisteredInterchainQuery(ctx sdk.Context, req *bindin
 	if grpcResp == nil {
 		return nil, sdkerrors.Wrapf(types.ErrEmptyResult, "interchain query response empty for query id %d", req.QueryId)
 	}
-	query := mapGRPCRegisteredQueryToWasmBindings(*grpcResp)
+	query, err := mapGRPCRegisteredQueryToWasmBindings(*grpcResp)
+	if err != nil {
+		return nil, err
+	}
 
 	return &bindings.QueryRegisteredQueryResponse{RegisteredQuery: &query}, nil
 }
 
-func mapGRPCRegisteredQueryToWasmBindings(grpcQuery types.RegisteredQuery) bindings.RegisteredQuery {
+func mapGRPCRegisteredQueryToWasmBindings(grpcQuery types.RegisteredQuery) (bindings.RegisteredQuery, error) {
+	tendermintQueries, err := grpcQuery.TendermintQueries()
+	if err != nil {
+		return bindings.RegisteredQuery{}, err
+	}
+
 	return bindings.RegisteredQuery{
 		Id:                              grpcQuery.GetId(),
 		Owner:                           grpcQuery.GetOwner(),
@@ -95,6 +311,13 @@ func mapGRPCRegisteredQueryToWasmBindings(grpcQuery types.RegisteredQuery) bindi
 		ConnectionId:                    grpcQuery.GetConnectionId(),
 		UpdatePeriod:                    grpcQuery.GetUpdatePeriod(),
 		LastSubmittedResultLocalHeight:  grpcQuery.GetLastSubmittedResultLocalHeight(),
-		LastSubmittedResultRemoteHeight: grpcQuery.GetLastSubmittedResultRemoteHeight(),
-	}
+		LastSubmittedResultRemoteHeight: grpcQuery.GetLastSubmittedResultRemoteHeight().GetRevisionHeight(),
+		LastSubmittedResultRevision:     grpcQuery.GetLastSubmittedResultRemoteHeight().GetRevisionNumber(),
+		SubmissionReward:                grpcQuery.GetSubmissionReward(),
+		RewardEscrow:                    grpcQuery.GetRewardEscrow(),
+		ResultHistorySize:               grpcQuery.GetResultHistorySize(),
+		TxMessagesFilter:                grpcQuery.GetTxMessagesFilter(),
+		Suspended:                       grpcQuery.GetSuspended(),
+		TendermintQueries:               tendermintQueries,
+	}, nil
 }
@@ -226,6 +226,45 @@ func (suite *CustomMessengerTestSuite) TestRemoveInterchainQueryFailed() {
 	suite.Nil(data)
 }
 
+func (suite *CustomMessengerTestSuite) TestTransferInterchainQueryOwnership() {
+	// reuse register interchain query test to get query registered
+	suite.TestRegisterInterchainQuery()
+
+	codeId := suite.StoreReflectCode(suite.ctx, suite.contractOwner, "../testdata/reflect.wasm")
+	newOwner := suite.InstantiateReflectContract(suite.ctx, suite.contractOwner, codeId)
+	suite.Require().NotEmpty(newOwner)
+
+	// Craft TransferInterchainQueryOwnership message
+	queryID := uint64(1)
+	fullMsg := bindings.NeutronMsg{
+		TransferInterchainQueryOwnership: &bindings.TransferInterchainQueryOwnership{
+			QueryId:  queryID,
+			NewOwner: newOwner.String(),
+		},
+	}
+
+	msg, err := json.Marshal(fullMsg)
+	suite.NoError(err)
+
+	// Dispatch TransferInterchainQueryOwnership message
+	events, data, err := suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
+		Custom: msg,
+	})
+	suite.NoError(err)
+	suite.Nil(events)
+	suite.Equal([][]byte{[]byte(`{}`)}, data)
+
+	query, err := suite.neutron.InterchainQueriesKeeper.GetQueryByID(suite.ctx, queryID)
+	suite.NoError(err)
+	suite.Equal(newOwner.String(), query.Owner)
+
+	// the previous owner can't transfer the query anymore
+	_, _, err = suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, types.CosmosMsg{
+		Custom: msg,
+	})
+	suite.Require().ErrorContains(err, "authorization failed")
+}
+
 func (suite *CustomMessengerTestSuite) TestSubmitTx() {
 	// Store code and instantiate reflect contract
 	codeId := suite.StoreReflectCode(suite.ctx, suite.contractOwner, "../testdata/reflect.wasm")
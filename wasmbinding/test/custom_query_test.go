@@ -4,15 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/wasmbinding"
 	"github.com/neutron-org/neutron/wasmbinding/bindings"
 	icqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
 	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
@@ -95,6 +105,385 @@ func (suite *CustomQuerierTestSuite) TestInterchainQueryResult() {
 	}}, resp.Result.KvResults)
 }
 
+func (suite *CustomQuerierTestSuite) TestInterchainQueryResultMaxAge() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		owner   = keeper.RandomAccountAddress(suite.T()) // We don't care what this address is
+	)
+
+	registeredQuery := icqtypes.RegisteredQuery{
+		Id:           1,
+		Owner:        owner.String(),
+		Keys:         []*icqtypes.KVKey{{Path: "bank", Key: []byte("key")}},
+		QueryType:    string(icqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+	}
+	neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, registeredQuery.Id)
+	err := neutron.InterchainQueriesKeeper.SaveQuery(ctx, registeredQuery)
+	suite.Require().NoError(err)
+
+	// the result is verified against the consensus state of the next block
+	clientState, found := neutron.IBCKeeper.ClientKeeper.GetClientState(ctx, suite.Path.EndpointA.ClientID)
+	suite.Require().True(found)
+	consensusHeight := clientState.GetLatestHeight()
+	consensusState, found := neutron.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, suite.Path.EndpointA.ClientID, consensusHeight)
+	suite.Require().True(found)
+
+	err = neutron.InterchainQueriesKeeper.SaveKVQueryResult(ctx, registeredQuery.Id, &icqtypes.QueryResult{
+		KvResults: []*icqtypes.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte("value")}},
+		Height:    consensusHeight.GetRevisionHeight() - 1,
+		Revision:  consensusHeight.GetRevisionNumber(),
+	})
+	suite.Require().NoError(err)
+	submittedAt := uint64(ctx.BlockHeight())
+
+	query := func(maxAgeBlocks, maxAgeSeconds uint64) (bindings.QueryRegisteredQueryResultResponse, error) {
+		resp := bindings.QueryRegisteredQueryResultResponse{}
+		err := suite.queryCustomDirectly(ctx, bindings.NeutronQuery{
+			InterchainQueryResult: &bindings.QueryRegisteredQueryResultRequest{
+				QueryId:       registeredQuery.Id,
+				MaxAgeBlocks:  maxAgeBlocks,
+				MaxAgeSeconds: maxAgeSeconds,
+			},
+		}, &resp)
+		return resp, err
+	}
+
+	resp, err := query(0, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(submittedAt, resp.LastSubmittedResultLocalHeight)
+	suite.Require().Equal(consensusState.GetTimestamp(), resp.RemoteBlockTime)
+	suite.Require().Equal(consensusHeight.GetRevisionHeight()-1, resp.Result.Height)
+
+	remoteTime := time.Unix(0, int64(consensusState.GetTimestamp()))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(remoteTime.Add(time.Minute))
+
+	_, err = query(10, 60)
+	suite.Require().NoError(err)
+
+	_, err = query(9, 0)
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+
+	_, err = query(0, 59)
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+
+	// the decoded result is guarded the same way
+	err = suite.queryCustomDirectly(ctx, bindings.NeutronQuery{
+		InterchainQueryDecodedResult: &bindings.QueryRegisteredQueryResultRequest{QueryId: registeredQuery.Id, MaxAgeBlocks: 9},
+	}, &bindings.QueryDecodedQueryResultResponse{})
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+
+	// the result of the previous revision is stale once the remote chain is upgraded, it's still returned to
+	// the requests without age limits
+	tmClientState := clientState.(*ibctmtypes.ClientState)
+	tmClientState.LatestHeight = clienttypes.NewHeight(consensusHeight.GetRevisionNumber()+1, 1)
+	neutron.IBCKeeper.ClientKeeper.SetClientState(ctx, suite.Path.EndpointA.ClientID, tmClientState)
+
+	_, err = query(100, 0)
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+
+	_, err = query(0, 0)
+	suite.Require().NoError(err)
+
+	tmClientState.LatestHeight = consensusHeight.(clienttypes.Height)
+	neutron.IBCKeeper.ClientKeeper.SetClientState(ctx, suite.Path.EndpointA.ClientID, tmClientState)
+
+	_, err = query(100, 0)
+	suite.Require().NoError(err)
+
+	// the result obtained over the previous connection of the query is stale after the query is moved
+	registeredQuery.LastSubmittedResultLocalHeight = submittedAt
+	err = neutron.InterchainQueriesKeeper.SaveQuery(ctx, registeredQuery)
+	suite.Require().NoError(err)
+
+	_, err = query(100, 0)
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+
+	_, err = query(0, 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *CustomQuerierTestSuite) TestInterchainQueryResultMaxAgeLocalhost() {
+	var (
+		neutron   = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx       = suite.ChainA.GetContext()
+		owner     = keeper.RandomAccountAddress(suite.T()) // We don't care what this address is
+		ibcKeeper = neutron.IBCKeeper
+		revision  = clienttypes.ParseChainID(ctx.ChainID())
+		height    = clienttypes.NewHeight(revision, uint64(ctx.BlockHeight()))
+	)
+
+	ibcKeeper.ClientKeeper.SetParams(ctx, clienttypes.NewParams(exported.Tendermint, exported.Localhost))
+	clientID, err := ibcKeeper.ClientKeeper.CreateClient(ctx, localhosttypes.NewClientState(ctx.ChainID(), height), nil)
+	suite.Require().NoError(err)
+
+	connectionID := ibcKeeper.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		clientID,
+		connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()),
+		0,
+	))
+
+	registeredQuery := icqtypes.RegisteredQuery{
+		Id:           1,
+		Owner:        owner.String(),
+		Keys:         []*icqtypes.KVKey{{Path: "bank", Key: []byte("key")}},
+		QueryType:    string(icqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		ConnectionId: connectionID,
+	}
+	neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, registeredQuery.Id)
+	err = neutron.InterchainQueriesKeeper.SaveQuery(ctx, registeredQuery)
+	suite.Require().NoError(err)
+
+	err = neutron.InterchainQueriesKeeper.SaveKVQueryResult(ctx, registeredQuery.Id, &icqtypes.QueryResult{
+		KvResults: []*icqtypes.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte("value")}},
+		Height:    height.GetRevisionHeight(),
+		Revision:  height.GetRevisionNumber(),
+	})
+	suite.Require().NoError(err)
+
+	query := func(maxAgeSeconds uint64) (bindings.QueryRegisteredQueryResultResponse, error) {
+		resp := bindings.QueryRegisteredQueryResultResponse{}
+		err := suite.queryCustomDirectly(ctx, bindings.NeutronQuery{
+			InterchainQueryResult: &bindings.QueryRegisteredQueryResultRequest{
+				QueryId:       registeredQuery.Id,
+				MaxAgeSeconds: maxAgeSeconds,
+			},
+		}, &resp)
+		return resp, err
+	}
+
+	// the remote block time of a localhost result is the local block time it has been obtained at
+	resultTime := ctx.BlockTime()
+	resp, err := query(0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(resultTime.UnixNano()), resp.RemoteBlockTime)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(resultTime.Add(time.Minute))
+
+	resp, err = query(60)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(resultTime.UnixNano()), resp.RemoteBlockTime)
+
+	_, err = query(59)
+	suite.Require().ErrorIs(err, icqtypes.ErrStaleQueryResult)
+}
+
+func (suite *CustomQuerierTestSuite) TestInterchainQueryResultHistory() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		owner   = keeper.RandomAccountAddress(suite.T()) // We don't care what this address is
+	)
+
+	registeredQuery := icqtypes.RegisteredQuery{
+		Id:                1,
+		Owner:             owner.String(),
+		Keys:              []*icqtypes.KVKey{{Path: "bank", Key: []byte("key")}},
+		QueryType:         string(icqtypes.InterchainQueryTypeKV),
+		UpdatePeriod:      1,
+		ConnectionId:      suite.Path.EndpointA.ConnectionID,
+		ResultHistorySize: 2,
+	}
+	neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, registeredQuery.Id)
+	err := neutron.InterchainQueriesKeeper.SaveQuery(ctx, registeredQuery)
+	suite.Require().NoError(err)
+
+	for height := uint64(1); height <= 3; height++ {
+		err = neutron.InterchainQueriesKeeper.SaveKVQueryResult(ctx, registeredQuery.Id, &icqtypes.QueryResult{
+			KvResults: []*icqtypes.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte{byte(height)}}},
+			Height:    height,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the reflect contract doesn't know about the query, so the custom querier is called directly
+	query := bindings.NeutronQuery{
+		InterchainQueryResultHistory: &bindings.QueryResultHistoryRequest{
+			QueryId: registeredQuery.Id,
+		},
+	}
+	resp := bindings.QueryResultHistoryResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Results, 2)
+	suite.Require().Equal(uint64(2), resp.Results[0].Height)
+	suite.Require().Equal(uint64(3), resp.Results[1].Height)
+	suite.Require().Equal([]byte{3}, resp.Results[1].KvResults[0].Value)
+
+	query.InterchainQueryResultHistory.MinHeight = 2
+	query.InterchainQueryResultHistory.MaxHeight = 2
+	resp = bindings.QueryResultHistoryResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Results, 1)
+	suite.Require().Equal(uint64(2), resp.Results[0].Height)
+}
+
+func (suite *CustomQuerierTestSuite) TestRegisteredInterchainQueries() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		owner   = keeper.RandomAccountAddress(suite.T())
+		other   = keeper.RandomAccountAddress(suite.T())
+	)
+
+	for id := uint64(1); id <= 4; id++ {
+		queryOwner := owner
+		if id%2 == 0 {
+			queryOwner = other
+		}
+		err := neutron.InterchainQueriesKeeper.SaveQuery(ctx, icqtypes.RegisteredQuery{
+			Id:           id,
+			Owner:        queryOwner.String(),
+			QueryType:    string(icqtypes.InterchainQueryTypeKV),
+			ConnectionId: suite.Path.EndpointA.ConnectionID,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the request without pagination returns all the queries of the owner
+	query := bindings.NeutronQuery{
+		RegisteredInterchainQueries: &bindings.QueryRegisteredQueriesRequest{Owners: []string{owner.String()}},
+	}
+	resp := bindings.QueryRegisteredQueriesResponse{}
+	err := suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.RegisteredQueries, 2)
+
+	// the pages are full and the next key leads to the rest of the queries
+	query.RegisteredInterchainQueries.Pagination = &sdkquery.PageRequest{Limit: 1, CountTotal: true}
+	resp = bindings.QueryRegisteredQueriesResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.RegisteredQueries, 1)
+	suite.Require().Equal(uint64(1), resp.RegisteredQueries[0].Id)
+	suite.Require().Equal(uint64(2), resp.Pagination.Total)
+
+	query.RegisteredInterchainQueries.Pagination = &sdkquery.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}
+	resp = bindings.QueryRegisteredQueriesResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.RegisteredQueries, 1)
+	suite.Require().Equal(uint64(3), resp.RegisteredQueries[0].Id)
+}
+
+func (suite *CustomQuerierTestSuite) TestInterchainQueryDepositEstimate() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+	)
+
+	params := neutron.InterchainQueriesKeeper.GetParams(ctx)
+	params.QueryDepositPerKey = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	neutron.InterchainQueriesKeeper.SetParams(ctx, params)
+
+	query := bindings.NeutronQuery{
+		InterchainQueryDepositEstimate: &bindings.QueryDepositEstimateRequest{
+			QueryType: string(icqtypes.InterchainQueryTypeKV),
+			KeysCount: 3,
+		},
+	}
+	resp := bindings.QueryDepositEstimateResponse{}
+	err := suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.QueryDeposit.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30))), resp.Deposit)
+
+	query.InterchainQueryDepositEstimate.QueryType = "unknown"
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().ErrorContains(err, icqtypes.ErrInvalidQueryType.Error())
+}
+
+func (suite *CustomQuerierTestSuite) TestInterchainQueryDecodedResult() {
+	var (
+		neutron = suite.GetNeutronZoneApp(suite.ChainA)
+		ctx     = suite.ChainA.GetContext()
+		owner   = keeper.RandomAccountAddress(suite.T()) // We don't care what this address is
+	)
+
+	balanceKey, err := icqtypes.NewBankBalanceKVKey(owner.String(), "untrn")
+	suite.Require().NoError(err)
+	balance := sdk.NewCoin("untrn", sdk.NewInt(42))
+
+	registeredQuery := icqtypes.RegisteredQuery{
+		Id:           1,
+		Owner:        owner.String(),
+		Keys:         []*icqtypes.KVKey{&balanceKey, {Path: host.StoreKey, Key: []byte("key")}},
+		QueryType:    string(icqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+	}
+	neutron.InterchainQueriesKeeper.SetLastRegisteredQueryKey(ctx, registeredQuery.Id)
+	err = neutron.InterchainQueriesKeeper.SaveQuery(ctx, registeredQuery)
+	suite.Require().NoError(err)
+
+	err = neutron.InterchainQueriesKeeper.SaveKVQueryResult(ctx, registeredQuery.Id, &icqtypes.QueryResult{
+		KvResults: []*icqtypes.StorageValue{
+			{StoragePrefix: balanceKey.Path, Key: balanceKey.Key, Value: neutron.AppCodec().MustMarshal(&balance)},
+			{StoragePrefix: host.StoreKey, Key: []byte("key"), Value: []byte("value")},
+		},
+		Height: 10,
+	})
+	suite.Require().NoError(err)
+
+	// the reflect contract doesn't know about the query, so the custom querier is called directly
+	query := bindings.NeutronQuery{
+		InterchainQueryDecodedResult: &bindings.QueryRegisteredQueryResultRequest{QueryId: registeredQuery.Id},
+	}
+	resp := bindings.QueryDecodedQueryResultResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(10), resp.Result.Height)
+	suite.Require().Len(resp.Result.KvResults, 2)
+
+	decodedBalance := resp.Result.KvResults[0]
+	suite.Require().Equal("cosmos.base.v1beta1.Coin", decodedBalance.Type)
+	suite.Require().JSONEq(`{"denom":"untrn","amount":"42"}`, string(decodedBalance.Decoded))
+	suite.Require().Empty(decodedBalance.Value)
+
+	unknown := resp.Result.KvResults[1]
+	suite.Require().Empty(unknown.Type)
+	suite.Require().Empty(unknown.Decoded)
+	suite.Require().Equal([]byte("value"), unknown.Value)
+}
+
+func (suite *CustomQuerierTestSuite) TestInterchainQueryKVKey() {
+	ctx := suite.ChainA.GetContext()
+
+	// a remote chain address with its own human-readable part
+	address, err := bech32.ConvertAndEncode("cosmos", keeper.RandomAccountAddress(suite.T()))
+	suite.Require().NoError(err)
+
+	expected, err := icqtypes.NewBankBalanceKVKey(address, "uatom")
+	suite.Require().NoError(err)
+
+	// the request is passed as raw JSON to make sure the contract facing format is stable
+	var query json.RawMessage = []byte(`{"interchain_query_kv_key":{"bank_balance":{"address":"` + address + `","denom":"uatom"}}}`)
+	resp := bindings.QueryKVKeyResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, resp.KVKey)
+
+	query = []byte(`{"interchain_query_kv_key":{"distribution_fee_pool":{}}}`)
+	resp = bindings.QueryKVKeyResponse{}
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().NoError(err)
+	suite.Require().Equal(icqtypes.NewDistributionFeePoolKVKey(), resp.KVKey)
+
+	query = []byte(`{"interchain_query_kv_key":{"bank_supply":{"denom":"uatom"},"gov_proposal":{"proposal_id":1}}}`)
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().ErrorContains(err, "exactly one kv key type must be set")
+
+	query = []byte(`{"interchain_query_kv_key":{"staking_validator":{"validator":"invalid"}}}`)
+	err = suite.queryCustomDirectly(ctx, query, &resp)
+	suite.Require().ErrorContains(err, "failed to decode address from bech32")
+}
+
 func (suite *CustomQuerierTestSuite) TestInterchainQueryResultNotFound() {
 	var (
 		ctx   = suite.ChainA.GetContext()
@@ -211,6 +600,22 @@ func (suite *CustomQuerierTestSuite) queryCustom(ctx sdk.Context, contract sdk.A
 	return json.Unmarshal(resp.Data, response)
 }
 
+// queryCustomDirectly passes the request to the neutron custom querier bypassing a contract.
+func (suite *CustomQuerierTestSuite) queryCustomDirectly(ctx sdk.Context, request interface{}, response interface{}) error {
+	neutron := suite.GetNeutronZoneApp(suite.ChainA)
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(&neutron.InterchainTxsKeeper, &neutron.InterchainQueriesKeeper))
+
+	requestBz, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	resBz, err := querier(ctx, requestBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBz, response)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(CustomQuerierTestSuite))
 }
@@ -0,0 +1,28 @@
+package interchainqueries
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// BeginBlocker suspends the interchain queries registered over the connections of the IBC clients
+// which got frozen or expired, and resumes them once the clients are active again.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.CheckQueryConnectionClients(ctx)
+}
+
+// EndBlocker removes the interchain queries which haven't got any results for too long and
+// cleans up the processed transactions of the removed TX queries.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.RemoveExpiredQueries(ctx)
+	k.RemoveProcessedTransactions(ctx)
+}
@@ -15,6 +15,9 @@ import (
 const (
 	flagOwners       = "owners"
 	flagConnectionID = "connection_id"
+	flagMinHeight    = "min_height"
+	flagMaxHeight    = "max_height"
+	flagRevision     = "revision"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -32,7 +35,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryRegisteredQueries())
 	cmd.AddCommand(CmdQueryRegisteredQuery())
 	cmd.AddCommand(CmdQueryRegisteredQueryResult())
+	cmd.AddCommand(CmdQueryDecodedQueryResult())
 	cmd.AddCommand(CmdQueryLastRemoteHeight())
+	cmd.AddCommand(CmdQueryQueriesDueForUpdate())
+	cmd.AddCommand(CmdQueryResultHistory())
+	cmd.AddCommand(CmdQuerySudoFailures())
+	cmd.AddCommand(CmdQueryDepositEstimate())
 
 	return cmd
 }
@@ -133,6 +141,35 @@ func CmdQueryRegisteredQueryResult() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryDecodedQueryResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decoded-query-result [query-id]",
+		Short: "queries result for registered query with the values of well-known keys decoded into JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			res, err := queryClient.DecodedQueryResult(context.Background(), &types.QueryRegisteredQueryResultRequest{QueryId: queryID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryLastRemoteHeight() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "query-last-remote-height [connection-id]",
@@ -155,3 +192,157 @@ func CmdQueryLastRemoteHeight() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryQueriesDueForUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queries-due-for-update",
+		Short: "queries the interchain queries which are due for an update at the current height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			connectionID, _ := cmd.Flags().GetString(flagConnectionID)
+
+			res, err := queryClient.QueriesDueForUpdate(context.Background(), &types.QueryQueriesDueForUpdateRequest{
+				Pagination:   pageReq,
+				ConnectionId: connectionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "(optional) filter by connection id")
+	flags.AddPaginationFlagsToCmd(cmd, "queries due for update")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryResultHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-result-history [query-id]",
+		Short: "queries results kept in the result history of registered KV query",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			minHeight, _ := cmd.Flags().GetUint64(flagMinHeight)
+			maxHeight, _ := cmd.Flags().GetUint64(flagMaxHeight)
+			revision, _ := cmd.Flags().GetUint64(flagRevision)
+
+			res, err := queryClient.QueryResultHistory(context.Background(), &types.QueryResultHistoryRequest{
+				QueryId:   queryID,
+				MinHeight: minHeight,
+				MaxHeight: maxHeight,
+				Revision:  revision,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMinHeight, 0, "(optional) lowest remote height of the results, inclusive")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "(optional) highest remote height of the results, inclusive")
+	cmd.Flags().Uint64(flagRevision, 0, "(optional) remote revision the heights of the results belong to")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySudoFailures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-failures [query-id]",
+		Short: "queries failed calls of the query owner contracts sudo handlers with the submitted query results",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var queryID uint64
+			if len(args) > 0 {
+				queryID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse query id: %w", err)
+				}
+			}
+
+			res, err := queryClient.SudoFailures(context.Background(), &types.QuerySudoFailuresRequest{
+				QueryId:    queryID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "sudo failures")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDepositEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-estimate [query-type] [keys-count]",
+		Short: "queries the deposit collected on registration of a query of the given type and amount of keys",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var keysCount uint64
+			if len(args) > 1 {
+				var err error
+				keysCount, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse keys count: %w", err)
+				}
+			}
+
+			res, err := queryClient.DepositEstimate(context.Background(), &types.QueryDepositEstimateRequest{
+				QueryType: args[0],
+				KeysCount: keysCount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,11 +9,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/neutron-org/neutron/x/interchainqueries/types"
 )
 
+const (
+	flagKeys               = "keys"
+	flagTransactionsFilter = "transactions_filter"
+	flagTxMessagesFilter   = "tx_messages_filter"
+	flagSubmissionReward   = "submission_reward"
+	flagResultHistorySize  = "result_history_size"
+	flagUpdatePeriod       = "update_period"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,7 +34,155 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(RegisterQueryCmd())
+	cmd.AddCommand(UpdateQueryCmd())
+	cmd.AddCommand(RemoveQueryCmd())
 	cmd.AddCommand(SubmitQueryResultCmd())
+	cmd.AddCommand(SubmitQueryResultsCmd())
+	cmd.AddCommand(FundQueryRewardCmd())
+	cmd.AddCommand(RetrySudoFailureCmd())
+	cmd.AddCommand(TransferQueryOwnershipCmd())
+
+	return cmd
+}
+
+func RegisterQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-query [query-type] [connection-id] [update-period]",
+		Short: "Register an interchain query owned by the sender",
+		Long: "Register an interchain query owned by the sender. KV queries need the --keys flag " +
+			"(comma separated path/hex_key pairs), TX queries need the --transactions_filter flag. " +
+			"Queries owned by an account which is not a contract only have their results stored, TX queries must be owned by contracts",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			updatePeriod, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse update period: %w", err)
+			}
+
+			msg := types.MsgRegisterInterchainQuery{
+				QueryType:    args[0],
+				ConnectionId: args[1],
+				UpdatePeriod: updatePeriod,
+				Sender:       clientCtx.GetFromAddress().String(),
+			}
+
+			if keys, _ := cmd.Flags().GetString(flagKeys); keys != "" {
+				if msg.Keys, err = types.KVKeysFromString(keys); err != nil {
+					return fmt.Errorf("failed to parse keys: %w", err)
+				}
+			}
+
+			msg.TransactionsFilter, _ = cmd.Flags().GetString(flagTransactionsFilter)
+			msg.TxMessagesFilter, _ = cmd.Flags().GetString(flagTxMessagesFilter)
+			msg.ResultHistorySize, _ = cmd.Flags().GetUint64(flagResultHistorySize)
+
+			if reward, _ := cmd.Flags().GetString(flagSubmissionReward); reward != "" {
+				if msg.SubmissionReward, err = sdk.ParseCoinsNormalized(reward); err != nil {
+					return fmt.Errorf("failed to parse submission reward: %w", err)
+				}
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagKeys, "", "KV query keys as comma separated path/hex_key pairs")
+	cmd.Flags().String(flagTransactionsFilter, "", "TX query transactions filter in JSON")
+	cmd.Flags().String(flagTxMessagesFilter, "", "(optional) TX query messages filter in JSON")
+	cmd.Flags().String(flagSubmissionReward, "", "(optional) reward paid to the relayer for each submitted result")
+	cmd.Flags().Uint64(flagResultHistorySize, 0, "(optional) number of previous KV results to keep")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func UpdateQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-query [query-id]",
+		Short: "Update the keys, the update period, the transactions filter or the connection of an interchain query owned by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			msg := types.MsgUpdateInterchainQueryRequest{
+				QueryId: queryID,
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+
+			if keys, _ := cmd.Flags().GetString(flagKeys); keys != "" {
+				if msg.NewKeys, err = types.KVKeysFromString(keys); err != nil {
+					return fmt.Errorf("failed to parse keys: %w", err)
+				}
+			}
+
+			msg.NewUpdatePeriod, _ = cmd.Flags().GetUint64(flagUpdatePeriod)
+			msg.NewTransactionsFilter, _ = cmd.Flags().GetString(flagTransactionsFilter)
+			msg.NewConnectionId, _ = cmd.Flags().GetString(flagConnectionID)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagKeys, "", "(optional) new KV query keys as comma separated path/hex_key pairs")
+	cmd.Flags().Uint64(flagUpdatePeriod, 0, "(optional) new update period")
+	cmd.Flags().String(flagTransactionsFilter, "", "(optional) new TX query transactions filter in JSON")
+	cmd.Flags().String(flagConnectionID, "", "(optional) new connection id")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RemoveQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-query [query-id]",
+		Short: "Remove an interchain query owned by the sender and get the deposit back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			msg := types.MsgRemoveInterchainQueryRequest{
+				QueryId: queryID,
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -71,3 +229,166 @@ func SubmitQueryResultCmd() *cobra.Command {
 
 	return cmd
 }
+
+func SubmitQueryResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-query-results [client-id] [revision] [height] [results-file]",
+		Short: "Submit KV results of many queries obtained at the same remote height",
+		Long: "Submit KV results of many queries obtained at the same remote height. " +
+			"The results file is a JSON array of results, each with query_id, kv_results and allow_kv_callbacks",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			revision, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse revision: %w", err)
+			}
+
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse height: %w", err)
+			}
+
+			results, err := ioutil.ReadFile(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to read query results file: %w", err)
+			}
+
+			msg := types.MsgSubmitQueryResults{
+				Sender:   clientCtx.GetFromAddress().String(),
+				ClientId: args[0],
+				Revision: revision,
+				Height:   height,
+			}
+			if err := json.Unmarshal(results, &msg.Results); err != nil {
+				return fmt.Errorf("failed to unmarshal query results: %w", err)
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func FundQueryRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-query-reward [query-id] [amount]",
+		Short: "Add funds to the relayer reward escrow of the query",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %w", err)
+			}
+
+			msg := types.MsgFundQueryReward{
+				QueryId: queryID,
+				Amount:  amount,
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RetrySudoFailureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-sudo-failure [query-id] [failure-id]",
+		Short: "Pass the query result the query owner contract has failed to process to the contract again",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			failureID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse failure id: %w", err)
+			}
+
+			msg := types.MsgRetrySudoFailure{
+				QueryId:   queryID,
+				FailureId: failureID,
+				Sender:    clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TransferQueryOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-query-ownership [query-id] [new-owner]",
+		Short: "Make another account the owner of an interchain query",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			msg := types.MsgTransferInterchainQueryOwnership{
+				QueryId:  queryID,
+				NewOwner: args[1],
+				Sender:   clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
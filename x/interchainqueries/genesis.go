@@ -11,12 +11,102 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+	k.SetLastRegisteredQueryKey(ctx, genState.LastRegisteredQueryId)
+
+	for _, query := range genState.RegisteredQueries {
+		if err := k.SaveQuery(ctx, query); err != nil {
+			panic(err)
+		}
+	}
+	// the owner queries counts and the indexes are derived from the imported queries only
+	k.RebuildQueryIndexes(ctx)
+	k.RebuildSuspendedConnections(ctx)
+
+	for _, record := range genState.QueryResults {
+		if err := k.SetQueryResult(ctx, record.QueryId, record.Result); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, tx := range genState.SubmittedTransactions {
+		k.SaveTransactionAsProcessed(ctx, tx.QueryId, tx.TxHash)
+	}
+
+	for _, record := range genState.QueryResultHistory {
+		if err := k.SetQueryResultHistoryRecord(ctx, record.QueryId, record.Result); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetLastSudoFailureID(ctx, genState.LastSudoFailureId)
+	for _, failure := range genState.SudoFailures {
+		if err := k.SetSudoFailure(ctx, failure); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, sequence := range genState.SoloMachineSequences {
+		k.SetSoloMachineSequence(ctx, sequence)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.LastRegisteredQueryId = k.GetLastRegisteredQueryKey(ctx)
+
+	txQueries := make(map[uint64]bool)
+	k.IterateRegisteredQueries(ctx, func(_ int64, query types.RegisteredQuery) (stop bool) {
+		genesis.RegisteredQueries = append(genesis.RegisteredQueries, query)
+		txQueries[query.Id] = types.InterchainQueryType(query.QueryType).IsTX()
+
+		if types.InterchainQueryType(query.QueryType).IsKV() {
+			if result, err := k.GetQueryResultByID(ctx, query.Id); err == nil {
+				genesis.QueryResults = append(genesis.QueryResults, types.QueryResultRecord{
+					QueryId: query.Id,
+					Result:  result,
+				})
+			}
+		}
+
+		return false
+	})
+
+	// processed transactions of already removed queries are not exported, as well as the ones of the queries
+	// moved to another connection: they are being removed and don't belong to the query anymore
+	k.IterateSubmittedTransactions(ctx, func(queryID uint64, txHash []byte) (stop bool) {
+		if !txQueries[queryID] || k.IsTxQueryToRemove(ctx, queryID) {
+			return false
+		}
+
+		genesis.SubmittedTransactions = append(genesis.SubmittedTransactions, types.SubmittedTransaction{
+			QueryId: queryID,
+			TxHash:  txHash,
+		})
+
+		return false
+	})
+
+	k.IterateQueryResultHistory(ctx, func(queryID uint64, result types.QueryResult) (stop bool) {
+		genesis.QueryResultHistory = append(genesis.QueryResultHistory, types.QueryResultRecord{
+			QueryId: queryID,
+			Result:  &result,
+		})
+
+		return false
+	})
+
+	genesis.LastSudoFailureId = k.GetLastSudoFailureID(ctx)
+	k.IterateSudoFailures(ctx, func(failure types.SudoFailure) (stop bool) {
+		genesis.SudoFailures = append(genesis.SudoFailures, failure)
+		return false
+	})
+
+	k.IterateSoloMachineSequences(ctx, func(sequence types.SoloMachineSequence) (stop bool) {
+		genesis.SoloMachineSequences = append(genesis.SoloMachineSequences, sequence)
+		return false
+	})
 
 	return genesis
 }
@@ -3,10 +3,12 @@ package interchainqueries_test
 import (
 	"testing"
 
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/neutron-org/neutron/testutil/interchainqueries/keeper"
 	"github.com/neutron-org/neutron/testutil/interchainqueries/nullify"
+	"github.com/neutron-org/neutron/testutil/interchainqueries/sample"
 	"github.com/neutron-org/neutron/x/interchainqueries"
 	"github.com/neutron-org/neutron/x/interchainqueries/types"
 )
@@ -28,3 +30,108 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 }
+
+func TestGenesisStateRoundTrip(t *testing.T) {
+	owner := sample.AccAddress()
+	genesisState := types.GenesisState{
+		Params:                types.DefaultParams(),
+		LastRegisteredQueryId: 3,
+		RegisteredQueries: []types.RegisteredQuery{
+			{
+				Id:                              1,
+				Owner:                           owner,
+				QueryType:                       string(types.InterchainQueryTypeKV),
+				Keys:                            []*types.KVKey{{Path: "bank", Key: []byte("key")}},
+				ConnectionId:                    "connection-0",
+				UpdatePeriod:                    10,
+				LastSubmittedResultLocalHeight:  5,
+				LastSubmittedResultRemoteHeight: ibcclienttypes.NewHeight(1, 100),
+				Deposit:                         types.DefaultQueryDeposit,
+				SubmitTimeout:                   types.DefaultQuerySubmitTimeout,
+				ResultHistorySize:               2,
+			},
+			{
+				Id:                 3,
+				Owner:              owner,
+				QueryType:          string(types.InterchainQueryTypeTX),
+				TransactionsFilter: `[{"field":"transfer.recipient","op":"Eq","value":"cosmos1"}]`,
+				ConnectionId:       "connection-1",
+				UpdatePeriod:       1,
+				Deposit:            types.DefaultQueryDeposit,
+				SubmitTimeout:      types.DefaultQuerySubmitTimeout,
+			},
+		},
+		QueryResults: []types.QueryResultRecord{
+			{
+				QueryId: 1,
+				Result: &types.QueryResult{
+					KvResults: []*types.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte("value")}},
+					Height:    100,
+					Revision:  1,
+				},
+			},
+		},
+		SubmittedTransactions: []types.SubmittedTransaction{
+			{QueryId: 3, TxHash: []byte("first tx hash")},
+			{QueryId: 3, TxHash: []byte("second tx hash")},
+		},
+		QueryResultHistory: []types.QueryResultRecord{
+			{
+				QueryId: 1,
+				Result: &types.QueryResult{
+					KvResults: []*types.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte("old value")}},
+					Height:    99,
+				},
+			},
+			{
+				QueryId: 1,
+				Result: &types.QueryResult{
+					KvResults: []*types.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte("value")}},
+					Height:    100,
+				},
+			},
+		},
+		LastSudoFailureId: 4,
+		SudoFailures: []types.SudoFailure{
+			{Id: 2, QueryId: 1, Contract: owner, Payload: []byte(`{"kv_query_result":{"query_id":1,"height":10,"revision":0}}`), Error: "failed", Height: 5},
+			{Id: 4, QueryId: 3, Contract: owner, Payload: []byte(`{"tx_query_result":{"query_id":3}}`), Error: "failed", Height: 6},
+		},
+		SoloMachineSequences: []types.SoloMachineSequence{
+			{QueryId: 1, ClientId: "06-solomachine-0", Sequence: 7},
+			{QueryId: 1, ClientId: "06-solomachine-1", Sequence: 3},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.InterchainQueriesKeeper(t)
+	interchainqueries.InitGenesis(ctx, *k, genesisState)
+
+	require.Equal(t, uint64(3), k.GetLastRegisteredQueryKey(ctx))
+	require.True(t, k.CheckTransactionIsAlreadyProcessed(ctx, 3, []byte("first tx hash")))
+	require.Equal(t, uint64(2), k.GetOwnerQueriesCount(ctx, owner))
+
+	// processed transactions of removed queries must not get into the exported genesis
+	k.SaveTransactionAsProcessed(ctx, 2, []byte("removed query tx hash"))
+
+	got := interchainqueries.ExportGenesis(ctx, *k)
+	require.NoError(t, got.Validate())
+
+	require.Equal(t, genesisState.LastRegisteredQueryId, got.LastRegisteredQueryId)
+	require.Equal(t, genesisState.RegisteredQueries, got.RegisteredQueries)
+	require.Equal(t, genesisState.QueryResults, got.QueryResults)
+	require.ElementsMatch(t, genesisState.SubmittedTransactions, got.SubmittedTransactions)
+	require.Equal(t, genesisState.QueryResultHistory, got.QueryResultHistory)
+	require.Equal(t, genesisState.LastSudoFailureId, got.LastSudoFailureId)
+	require.Equal(t, genesisState.SudoFailures, got.SudoFailures)
+	require.Equal(t, genesisState.SoloMachineSequences, got.SoloMachineSequences)
+
+	// the imported state must be exported again with no changes
+	k2, ctx2 := keepertest.InterchainQueriesKeeper(t)
+	interchainqueries.InitGenesis(ctx2, *k2, *got)
+	require.Equal(t, got, interchainqueries.ExportGenesis(ctx2, *k2))
+
+	// processed transactions of the query moved to another connection are being removed, they aren't exported
+	k.MarkTxQueryToRemove(ctx, 3)
+	got = interchainqueries.ExportGenesis(ctx, *k)
+	require.Empty(t, got.SubmittedTransactions)
+}
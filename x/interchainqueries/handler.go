@@ -26,6 +26,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitQueryResult(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSubmitQueryResults:
+			res, err := msgServer.SubmitQueryResults(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
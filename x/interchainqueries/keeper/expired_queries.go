@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// RemoveExpiredQueries checks up to Params.ExpiryChecksPerBlock registered queries, starting right after
+// the last checked one, and removes the queries which haven't got any results for more than
+// Params.QueryExpiryPeriod blocks. Deposits of the removed queries are either returned to the query
+// owners or burnt, depending on Params.BurnExpiredQueryDeposit.
+func (k Keeper) RemoveExpiredQueries(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.QueryExpiryPeriod == 0 || params.ExpiryChecksPerBlock == 0 {
+		return
+	}
+
+	expiredQueries := k.getExpiredQueries(ctx, params.QueryExpiryPeriod, params.ExpiryChecksPerBlock)
+	for i := range expiredQueries {
+		k.removeExpiredQuery(ctx, &expiredQueries[i], params.BurnExpiredQueryDeposit)
+	}
+}
+
+// getExpiredQueries iterates over at most limit registered queries starting right after the last checked
+// one and returns the expired ones. When the end of the registered queries list is reached, the next
+// check starts from the beginning of the list.
+func (k Keeper) getExpiredQueries(ctx sdk.Context, expiryPeriod uint64, limit uint64) []types.RegisteredQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredQueryKey)
+
+	lastCheckedID := k.getLastExpiryCheckedQueryID(ctx)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(lastCheckedID+1), nil)
+	defer iterator.Close()
+
+	var (
+		expiredQueries []types.RegisteredQuery
+		checked        uint64
+	)
+	for ; iterator.Valid() && checked < limit; iterator.Next() {
+		var query types.RegisteredQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &query)
+
+		if query.IsExpired(uint64(ctx.BlockHeight()), expiryPeriod) {
+			expiredQueries = append(expiredQueries, query)
+		}
+
+		lastCheckedID = query.Id
+		checked++
+	}
+
+	if !iterator.Valid() {
+		lastCheckedID = 0
+	}
+	k.setLastExpiryCheckedQueryID(ctx, lastCheckedID)
+
+	return expiredQueries
+}
+
+// removeExpiredQuery removes the expired query and returns its deposit to the query owner or burns it.
+// The funds left in the query reward escrow are always returned to the query owner.
+func (k Keeper) removeExpiredQuery(ctx sdk.Context, query *types.RegisteredQuery, burnDeposit bool) {
+	k.removeQuery(ctx, query)
+
+	if burnDeposit {
+		if err := k.bank.BurnCoins(ctx, types.ModuleName, query.Deposit); err != nil {
+			panic(err.Error())
+		}
+	} else {
+		owner, err := query.GetOwnerAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		k.MustPayOutDeposit(ctx, query.Deposit, owner)
+	}
+	k.mustRefundRewardEscrow(ctx, query)
+
+	ctx.EventManager().EmitEvents(getEventsQueryRemoved(query))
+	k.Logger(ctx).Debug("Removed expired query", "query_id", query.Id, "deposit_burnt", burnDeposit)
+}
+
+func (k Keeper) getLastExpiryCheckedQueryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastExpiryCheckedQueryIdKey)
+	if bytes == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bytes)
+}
+
+func (k Keeper) setLastExpiryCheckedQueryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastExpiryCheckedQueryIdKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func (suite *KeeperTestSuite) TestRemoveExpiredQueries() {
+	tests := []struct {
+		name                 string
+		burnDeposit          bool
+		expiryChecksPerBlock uint64
+		blocksPassed         int64
+		expectedRemoved      []uint64
+		expectedKept         []uint64
+		expectedBalance      sdktypes.Int
+	}{
+		{
+			"queries are not expired yet",
+			false,
+			100,
+			10,
+			nil,
+			[]uint64{1, 2},
+			sdktypes.NewInt(0),
+		},
+		{
+			"expired queries deposits are returned",
+			false,
+			100,
+			11,
+			[]uint64{1, 2},
+			nil,
+			sdktypes.NewInt(2_000_000),
+		},
+		{
+			"expired queries deposits are burnt",
+			true,
+			100,
+			11,
+			[]uint64{1, 2},
+			nil,
+			sdktypes.NewInt(0),
+		},
+		{
+			"expiry checks are limited per block",
+			false,
+			1,
+			11,
+			[]uint64{1},
+			[]uint64{2},
+			sdktypes.NewInt(1_000_000),
+		},
+	}
+
+	for i, tc := range tests {
+		tt := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tt.name, i, len(tests)), func() {
+			suite.SetupTest()
+
+			var (
+				ctx           = suite.ChainA.GetContext()
+				contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+				neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+				iqkeeper      = neutronApp.InterchainQueriesKeeper
+			)
+
+			// Store code and instantiate reflect contract.
+			codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+			contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+			suite.Require().NotEmpty(contractAddress)
+
+			err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+			suite.Require().NoError(err)
+
+			params := iqkeeper.GetParams(ctx)
+			params.QueryExpiryPeriod = 10
+			params.ExpiryChecksPerBlock = tt.expiryChecksPerBlock
+			params.BurnExpiredQueryDeposit = tt.burnDeposit
+			iqkeeper.SetParams(ctx, params)
+
+			msgSrv := keeper.NewMsgServerImpl(iqkeeper)
+			senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+			for j := 0; j < 2; j++ {
+				// Top up contract address with native coins for deposit
+				suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+				_, err = msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId:       suite.Path.EndpointA.ConnectionID,
+					TransactionsFilter: "[]",
+					QueryType:          string(iqtypes.InterchainQueryTypeTX),
+					UpdatePeriod:       1,
+					Sender:             contractAddress.String(),
+				})
+				suite.Require().NoError(err)
+			}
+
+			supplyBefore := neutronApp.BankKeeper.GetSupply(ctx, sdktypes.DefaultBondDenom)
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + tt.blocksPassed)
+			iqkeeper.RemoveExpiredQueries(ctx)
+
+			for _, id := range tt.expectedRemoved {
+				_, err = iqkeeper.GetQueryByID(ctx, id)
+				suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryID)
+				suite.Require().True(iqkeeper.IsTxQueryToRemove(ctx, id))
+			}
+			for _, id := range tt.expectedKept {
+				_, err = iqkeeper.GetQueryByID(ctx, id)
+				suite.Require().NoError(err)
+			}
+
+			balance := neutronApp.BankKeeper.GetBalance(ctx, contractAddress, sdktypes.DefaultBondDenom)
+			suite.Require().Equal(tt.expectedBalance, balance.Amount)
+
+			moduleAddress := neutronApp.AccountKeeper.GetModuleAddress(iqtypes.ModuleName)
+			moduleBalance := neutronApp.BankKeeper.GetBalance(ctx, moduleAddress, sdktypes.DefaultBondDenom)
+			suite.Require().Equal(sdktypes.NewInt(int64(len(tt.expectedKept)*1_000_000)), moduleBalance.Amount)
+
+			supplyAfter := neutronApp.BankKeeper.GetSupply(ctx, sdktypes.DefaultBondDenom)
+			if tt.burnDeposit {
+				suite.Require().Equal(supplyBefore.Amount.Sub(sdktypes.NewInt(2_000_000)), supplyAfter.Amount)
+			} else {
+				suite.Require().Equal(supplyBefore, supplyAfter)
+			}
+
+			// the next check starts from the query following the last checked one
+			if len(tt.expectedKept) > 0 && len(tt.expectedRemoved) > 0 {
+				iqkeeper.RemoveExpiredQueries(ctx)
+				_, err = iqkeeper.GetQueryByID(ctx, tt.expectedKept[0])
+				suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryID)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuspendedQueriesDontExpire() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		height   = uint64(ctx.BlockHeight())
+	)
+
+	params := iqkeeper.GetParams(ctx)
+	params.QueryExpiryPeriod = 10
+	iqkeeper.SetParams(ctx, params)
+
+	query := iqtypes.RegisteredQuery{
+		Id:                 1,
+		Owner:              wasmKeeper.RandomAccountAddress(suite.T()).String(),
+		QueryType:          string(iqtypes.InterchainQueryTypeKV),
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		RegisteredAtHeight: height,
+		Suspended:          true,
+	}
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, query))
+
+	// the query isn't removed while it's suspended
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+	iqkeeper.RemoveExpiredQueries(ctx)
+	_, err := iqkeeper.GetQueryByID(ctx, query.Id)
+	suite.Require().NoError(err)
+
+	// the expiry period of the resumed query is counted from the height it's resumed at
+	query.Suspended = false
+	query.ResumedAtHeight = uint64(ctx.BlockHeight())
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, query))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	iqkeeper.RemoveExpiredQueries(ctx)
+	_, err = iqkeeper.GetQueryByID(ctx, query.Id)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	iqkeeper.RemoveExpiredQueries(ctx)
+	_, err = iqkeeper.GetQueryByID(ctx, query.Id)
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryID)
+}
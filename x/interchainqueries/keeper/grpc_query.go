@@ -7,9 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	querytypes "github.com/cosmos/cosmos-sdk/types/query"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	contypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
-	tndtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
-	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -26,7 +25,12 @@ func (k Keeper) RegisteredQuery(goCtx context.Context, request *types.QueryRegis
 		return nil, sdkerrors.Wrapf(types.ErrInvalidQueryID, "failed to get registered query by query id: %v", err)
 	}
 
-	return &types.QueryRegisteredQueryResponse{RegisteredQuery: registeredQuery}, nil
+	tendermintQueries, err := registeredQuery.TendermintQueries()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRegisteredQueryResponse{RegisteredQuery: registeredQuery, TendermintQueries: tendermintQueries}, nil
 }
 
 func (k Keeper) RegisteredQueries(goCtx context.Context, req *types.QueryRegisteredQueriesRequest) (*types.QueryRegisteredQueriesResponse, error) {
@@ -34,37 +38,74 @@ func (k Keeper) RegisteredQueries(goCtx context.Context, req *types.QueryRegiste
 	return k.GetRegisteredQueries(ctx, req)
 }
 
+// GetRegisteredQueries returns the registered queries of the owners on the connection. The queries of a single
+// owner or on a connection are looked up by the corresponding index, the queries of many owners on any connection
+// are looked up among all the queries. The filters are applied before pagination, so the pages are full and
+// the total is counted right.
 func (k Keeper) GetRegisteredQueries(ctx sdk.Context, req *types.QueryRegisteredQueriesRequest) (*types.QueryRegisteredQueriesResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
 	var (
-		store   = prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredQueryKey)
-		queries []types.RegisteredQuery
+		owners       = newOwnersStore(req.GetOwners())
+		connectionID = req.GetConnectionId()
+		indexPrefix  []byte
+		queries      []types.RegisteredQuery
 	)
 
-	var owners = newOwnersStore(req.GetOwners())
-	pageRes, err := querytypes.Paginate(store, req.Pagination, func(key, value []byte) error {
-		query := types.RegisteredQuery{}
-		k.cdc.MustUnmarshal(value, &query)
+	switch {
+	case len(owners) == 1:
+		indexPrefix = types.GetQueryByOwnerKeyPrefix(req.GetOwners()[0])
+	case connectionID != "":
+		indexPrefix = types.GetQueryByConnectionKeyPrefix(connectionID)
+	}
 
-		var (
-			passedOwnerFilter        = owners.Has(query.GetOwner())
-			passedConnectionIDFilter = req.GetConnectionId() == "" || query.ConnectionId == req.GetConnectionId()
-		)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredQueryKey)
+	if indexPrefix != nil {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	}
 
-		if passedOwnerFilter && passedConnectionIDFilter {
+	pageRes, err := querytypes.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var query types.RegisteredQuery
+		if indexPrefix != nil {
+			indexedQuery, err := k.GetQueryByID(ctx, sdk.BigEndianToUint64(key))
+			if err != nil {
+				return false, err
+			}
+			query = *indexedQuery
+		} else if err := k.cdc.Unmarshal(value, &query); err != nil {
+			return false, err
+		}
+
+		if !owners.Has(query.GetOwner()) || (connectionID != "" && query.ConnectionId != connectionID) {
+			return false, nil
+		}
+
+		if accumulate {
 			queries = append(queries, query)
 		}
 
-		return nil
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
 	}
 
-	return &types.QueryRegisteredQueriesResponse{RegisteredQueries: queries, Pagination: pageRes}, nil
+	var tendermintQueries []types.TendermintQueries
+	for _, query := range queries {
+		if !types.InterchainQueryType(query.QueryType).IsTX() {
+			continue
+		}
+
+		rendered, err := query.TendermintQueries()
+		if err != nil {
+			return nil, err
+		}
+		tendermintQueries = append(tendermintQueries, types.TendermintQueries{QueryId: query.Id, Queries: rendered})
+	}
+
+	return &types.QueryRegisteredQueriesResponse{RegisteredQueries: queries, Pagination: pageRes, TendermintQueries: tendermintQueries}, nil
 }
 
 func (k Keeper) QueryResult(goCtx context.Context, request *types.QueryRegisteredQueryResultRequest) (*types.QueryRegisteredQueryResultResponse, error) {
@@ -81,6 +122,30 @@ func (k Keeper) QueryResult(goCtx context.Context, request *types.QueryRegistere
 	return &types.QueryRegisteredQueryResultResponse{Result: result}, nil
 }
 
+func (k Keeper) DecodedQueryResult(goCtx context.Context, request *types.QueryRegisteredQueryResultRequest) (*types.QueryDecodedQueryResultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.checkRegisteredQueryExists(ctx, request.QueryId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQueryID, "query with id %d doesn't exist", request.QueryId)
+	}
+
+	result, err := k.GetQueryResultByID(ctx, request.QueryId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to get query result by query id: %v", err)
+	}
+
+	resp := types.QueryDecodedQueryResultResponse{
+		KvResults: make([]types.DecodedStorageValue, 0, len(result.KvResults)),
+		Height:    result.Height,
+		Revision:  result.Revision,
+	}
+	for _, value := range result.KvResults {
+		resp.KvResults = append(resp.KvResults, types.DecodeStorageValue(k.cdc, *value))
+	}
+
+	return &resp, nil
+}
+
 func (k Keeper) LastRemoteHeight(goCtx context.Context, request *types.QueryLastRemoteHeight) (*types.QueryLastRemoteHeightResponse, error) {
 	req := contypes.QueryConnectionClientStateRequest{ConnectionId: request.ConnectionId}
 	r, err := k.ibcKeeper.ConnectionClientState(goCtx, &req)
@@ -89,13 +154,110 @@ func (k Keeper) LastRemoteHeight(goCtx context.Context, request *types.QueryLast
 	}
 	clientState := r.GetIdentifiedClientState().GetClientState()
 
-	m := new(tndtypes.ClientState)
-	err = proto.Unmarshal(clientState.Value, m)
+	m, err := ibcclienttypes.UnpackClientState(clientState)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "can't unmarshal client state")
+		return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "can't unpack client state")
 	}
 
-	return &types.QueryLastRemoteHeightResponse{Height: m.LatestHeight.RevisionHeight}, nil
+	return &types.QueryLastRemoteHeightResponse{
+		Height: ibcclienttypes.NewHeight(m.GetLatestHeight().GetRevisionNumber(), m.GetLatestHeight().GetRevisionHeight()),
+	}, nil
+}
+
+func (k Keeper) QueriesDueForUpdate(goCtx context.Context, req *types.QueryQueriesDueForUpdateRequest) (*types.QueryQueriesDueForUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		ctx          = sdk.UnwrapSDKContext(goCtx)
+		store        = prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredQueryKey)
+		connectionID = req.GetConnectionId()
+		blockHeight  = uint64(ctx.BlockHeight())
+		queries      []types.RegisteredQuery
+	)
+
+	if connectionID != "" {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueryByConnectionKeyPrefix(connectionID))
+	}
+
+	pageRes, err := querytypes.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var query types.RegisteredQuery
+		if connectionID != "" {
+			indexedQuery, err := k.GetQueryByID(ctx, sdk.BigEndianToUint64(key))
+			if err != nil {
+				return false, err
+			}
+			query = *indexedQuery
+		} else if err := k.cdc.Unmarshal(value, &query); err != nil {
+			return false, err
+		}
+
+		if query.Suspended || !query.IsDueForUpdate(blockHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			queries = append(queries, query)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryQueriesDueForUpdateResponse{RegisteredQueries: queries, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueryResultHistory(goCtx context.Context, req *types.QueryResultHistoryRequest) (*types.QueryResultHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.checkRegisteredQueryExists(ctx, req.QueryId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQueryID, "query with id %d doesn't exist", req.QueryId)
+	}
+
+	results, err := k.GetQueryResultHistory(ctx, req.QueryId, req.Revision, req.MinHeight, req.MaxHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to get query result history by query id: %v", err)
+	}
+
+	return &types.QueryResultHistoryResponse{Results: results}, nil
+}
+
+func (k Keeper) SudoFailures(goCtx context.Context, req *types.QuerySudoFailuresRequest) (*types.QuerySudoFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		ctx       = sdk.UnwrapSDKContext(goCtx)
+		keyPrefix = types.SudoFailureKey
+		failures  []types.SudoFailure
+	)
+	if req.GetQueryId() != 0 {
+		keyPrefix = types.GetSudoFailureKeyPrefix(req.GetQueryId())
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pageRes, err := querytypes.Paginate(store, req.Pagination, func(key, value []byte) error {
+		failure := types.SudoFailure{}
+		if err := k.cdc.Unmarshal(value, &failure); err != nil {
+			return err
+		}
+
+		failures = append(failures, failure)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QuerySudoFailuresResponse{Failures: failures, Pagination: pageRes}, nil
 }
 
 type ownersStore map[string]bool
@@ -117,3 +279,24 @@ func (o ownersStore) Has(addr string) bool {
 
 	return o[addr]
 }
+
+func (k Keeper) DepositEstimate(goCtx context.Context, req *types.QueryDepositEstimateRequest) (*types.QueryDepositEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	queryType := types.InterchainQueryType(req.GetQueryType())
+	if !queryType.IsValid() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidQueryType, "invalid query type: %s", req.GetQueryType())
+	}
+
+	if queryType.IsTX() && req.GetKeysCount() > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "TX queries have no keys")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryDepositEstimateResponse{
+		Deposit: k.GetParams(ctx).QueryDepositFor(queryType, req.GetKeysCount()),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
@@ -29,7 +30,8 @@ func (suite *KeeperTestSuite) TestRemoteLastHeight() {
 
 				oldHeight, err := keeper.Keeper.LastRemoteHeight(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper, sdk.WrapSDKContext(ctx), &iqtypes.QueryLastRemoteHeight{ConnectionId: suite.Path.EndpointA.ConnectionID})
 				suite.Require().NoError(err)
-				suite.Require().Greater(oldHeight.Height, uint64(0))
+				suite.Require().Greater(oldHeight.Height.GetRevisionHeight(), uint64(0))
+				suite.Require().Equal(suite.ChainB.LastHeader.GetHeight().GetRevisionNumber(), oldHeight.Height.GetRevisionNumber())
 
 				// update client N times
 				N := uint64(100)
@@ -38,8 +40,8 @@ func (suite *KeeperTestSuite) TestRemoteLastHeight() {
 				}
 
 				updatedHeight, err := keeper.Keeper.LastRemoteHeight(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper, sdk.WrapSDKContext(ctx), &iqtypes.QueryLastRemoteHeight{ConnectionId: suite.Path.EndpointA.ConnectionID})
-				suite.Require().Equal(updatedHeight.Height, oldHeight.Height+N) // check that last remote height really equals oldHeight+N
 				suite.Require().NoError(err)
+				suite.Require().Equal(oldHeight.Height.GetRevisionHeight()+N, updatedHeight.Height.GetRevisionHeight()) // check that last remote height really equals oldHeight+N
 			},
 		},
 	}
@@ -52,3 +54,195 @@ func (suite *KeeperTestSuite) TestRemoteLastHeight() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisteredQueries() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		alice    = "cosmos1alice"
+		bob      = "cosmos1bob"
+	)
+
+	// the queries of the owners are interleaved, so filtering the page would make it short
+	for id := uint64(1); id <= 10; id++ {
+		owner, connectionID := alice, "connection-0"
+		if id%2 == 0 {
+			owner = bob
+		}
+		if id%3 == 0 {
+			connectionID = "connection-1"
+		}
+		suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+			Id:           id,
+			Owner:        owner,
+			ConnectionId: connectionID,
+			QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		}))
+	}
+
+	queryIDs := func(req *iqtypes.QueryRegisteredQueriesRequest) ([]uint64, *query.PageResponse) {
+		res, err := iqkeeper.RegisteredQueries(sdk.WrapSDKContext(ctx), req)
+		suite.Require().NoError(err)
+
+		var ids []uint64
+		for _, registeredQuery := range res.RegisteredQueries {
+			ids = append(ids, registeredQuery.Id)
+		}
+		return ids, res.Pagination
+	}
+
+	// the pages of the owner queries are full and the total is the amount of the owner queries
+	ids, page := queryIDs(&iqtypes.QueryRegisteredQueriesRequest{
+		Owners:     []string{alice},
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().Equal([]uint64{1, 3}, ids)
+	suite.Require().Equal(uint64(5), page.Total)
+
+	ids, _ = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{
+		Owners:     []string{alice},
+		Pagination: &query.PageRequest{Key: page.NextKey, Limit: 2},
+	})
+	suite.Require().Equal([]uint64{5, 7}, ids)
+
+	ids, page = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{
+		ConnectionId: "connection-1",
+		Pagination:   &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().Equal([]uint64{3, 6}, ids)
+	suite.Require().Equal(uint64(3), page.Total)
+
+	ids, _ = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{Owners: []string{bob}, ConnectionId: "connection-1"})
+	suite.Require().Equal([]uint64{6}, ids)
+
+	ids, page = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{
+		Owners:     []string{alice, bob},
+		Pagination: &query.PageRequest{Offset: 8, CountTotal: true},
+	})
+	suite.Require().Equal([]uint64{9, 10}, ids)
+	suite.Require().Equal(uint64(10), page.Total)
+
+	// the indexes follow the changes of the owner and the connection and the removal of the queries
+	registeredQuery, err := iqkeeper.GetQueryByID(ctx, 6)
+	suite.Require().NoError(err)
+	registeredQuery.Owner = alice
+	registeredQuery.ConnectionId = "connection-0"
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, *registeredQuery))
+	iqkeeper.RemoveQueryByID(ctx, 3)
+
+	ids, _ = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{Owners: []string{alice}})
+	suite.Require().Equal([]uint64{1, 5, 6, 7, 9}, ids)
+	ids, _ = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{Owners: []string{bob}})
+	suite.Require().Equal([]uint64{2, 4, 8, 10}, ids)
+	ids, _ = queryIDs(&iqtypes.QueryRegisteredQueriesRequest{ConnectionId: "connection-1"})
+	suite.Require().Equal([]uint64{9}, ids)
+}
+
+func (suite *KeeperTestSuite) TestQueriesDueForUpdate() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext().WithBlockHeight(100)
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		owner    = suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	)
+
+	queries := []iqtypes.RegisteredQuery{
+		// never updated
+		{Id: 1, Owner: owner, ConnectionId: "connection-0", UpdatePeriod: 10},
+		// updated long ago
+		{Id: 2, Owner: owner, ConnectionId: "connection-1", UpdatePeriod: 10, LastSubmittedResultLocalHeight: 90},
+		// updated recently
+		{Id: 3, Owner: owner, ConnectionId: "connection-0", UpdatePeriod: 10, LastSubmittedResultLocalHeight: 95},
+		// updated long ago
+		{Id: 4, Owner: owner, ConnectionId: "connection-0", UpdatePeriod: 5, LastSubmittedResultLocalHeight: 95},
+	}
+	for _, query := range queries {
+		suite.Require().NoError(iqkeeper.SaveQuery(ctx, query))
+	}
+
+	tests := []struct {
+		name         string
+		request      *iqtypes.QueryQueriesDueForUpdateRequest
+		expectedIDs  []uint64
+		expectedNext bool
+	}{
+		{
+			"all queries due for update",
+			&iqtypes.QueryQueriesDueForUpdateRequest{},
+			[]uint64{1, 2, 4},
+			false,
+		},
+		{
+			"filtered by connection",
+			&iqtypes.QueryQueriesDueForUpdateRequest{ConnectionId: "connection-0"},
+			[]uint64{1, 4},
+			false,
+		},
+		{
+			"paginated",
+			&iqtypes.QueryQueriesDueForUpdateRequest{Pagination: &query.PageRequest{Limit: 2}},
+			[]uint64{1, 2},
+			true,
+		},
+	}
+
+	for i, tc := range tests {
+		tt := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tt.name, i, len(tests)), func() {
+			res, err := iqkeeper.QueriesDueForUpdate(sdk.WrapSDKContext(ctx), tt.request)
+			suite.Require().NoError(err)
+
+			ids := make([]uint64, 0, len(res.RegisteredQueries))
+			for _, q := range res.RegisteredQueries {
+				ids = append(ids, q.Id)
+			}
+			suite.Require().Equal(tt.expectedIDs, ids)
+			suite.Require().Equal(tt.expectedNext, len(res.Pagination.NextKey) > 0)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisteredQueryTendermintQueries() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+	)
+
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+		Id:           1,
+		Owner:        "cosmos1alice",
+		ConnectionId: "connection-0",
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+	}))
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+		Id:                 2,
+		Owner:              "cosmos1alice",
+		ConnectionId:       "connection-0",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		TransactionsFilter: `[{"field":"tx.height","op":"gt","value":10},{"or":[[{"field":"transfer.recipient","op":"eq","value":"cosmos1"}],[{"field":"transfer.sender","op":"eq","value":"cosmos1"}]]}]`,
+	}))
+
+	expected := []string{
+		"tx.height > 10 AND transfer.recipient = 'cosmos1'",
+		"tx.height > 10 AND transfer.sender = 'cosmos1'",
+	}
+
+	res, err := iqkeeper.RegisteredQuery(sdk.WrapSDKContext(ctx), &iqtypes.QueryRegisteredQueryRequest{QueryId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, res.TendermintQueries)
+
+	// there are no queries to run for a KV query
+	res, err = iqkeeper.RegisteredQuery(sdk.WrapSDKContext(ctx), &iqtypes.QueryRegisteredQueryRequest{QueryId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.TendermintQueries)
+
+	list, err := iqkeeper.RegisteredQueries(sdk.WrapSDKContext(ctx), &iqtypes.QueryRegisteredQueriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(list.RegisteredQueries, 2)
+	suite.Require().Equal([]iqtypes.TendermintQueries{{QueryId: 2, Queries: expected}}, list.TendermintQueries)
+}
@@ -5,14 +5,15 @@ import (
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
-	tendermintLightClientTypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/neutron-org/neutron/internal/sudo"
@@ -21,23 +22,25 @@ import (
 
 const (
 	LabelRegisterInterchainQuery = "register_interchain_query"
+	LabelSubmitQueryResults      = "submit_query_results"
 )
 
 type (
 	Keeper struct {
-		cdc         codec.BinaryCodec
-		storeKey    storetypes.StoreKey
-		memKey      storetypes.StoreKey
-		paramstore  paramtypes.Subspace
-		ibcKeeper   *ibckeeper.Keeper
-		wasmKeeper  *wasm.Keeper
-		bank        types.BankKeeper
-		sudoHandler sudo.Handler
+		cdc            codec.Codec
+		storeKey       storetypes.StoreKey
+		memKey         storetypes.StoreKey
+		paramstore     paramtypes.Subspace
+		ibcKeeper      *ibckeeper.Keeper
+		wasmKeeper     *wasm.Keeper
+		bank           types.BankKeeper
+		sudoHandler    sudo.Handler
+		proofVerifiers map[string]ProofVerifier
 	}
 )
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
@@ -59,6 +62,10 @@ func NewKeeper(
 		wasmKeeper:  wasmKeeper,
 		bank:        bank,
 		sudoHandler: sudo.NewSudoHandler(wasmKeeper, types.ModuleName),
+		proofVerifiers: map[string]ProofVerifier{
+			exported.Tendermint:  NewTendermintProofVerifier(ibcKeeper),
+			exported.Solomachine: NewSoloMachineProofVerifier(cdc, storeKey),
+		},
 	}
 }
 
@@ -81,6 +88,8 @@ func (k Keeper) SetLastRegisteredQueryKey(ctx sdk.Context, id uint64) {
 	store.Set(types.LastRegisteredQueryIdKey, sdk.Uint64ToBigEndian(id))
 }
 
+// SaveQuery saves the query and keeps the amount of the queries of its owner and the owner and connection
+// indexes up to date.
 func (k Keeper) SaveQuery(ctx sdk.Context, query types.RegisteredQuery) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -89,6 +98,25 @@ func (k Keeper) SaveQuery(ctx sdk.Context, query types.RegisteredQuery) error {
 		return sdkerrors.Wrapf(types.ErrProtoMarshal, "failed to marshal registered query: %v", err)
 	}
 
+	var previousOwner, previousConnectionID string
+	if prevQuery, err := k.GetQueryByID(ctx, query.Id); err == nil {
+		previousOwner, previousConnectionID = prevQuery.Owner, prevQuery.ConnectionId
+	}
+	if previousOwner != query.Owner {
+		if previousOwner != "" {
+			k.setOwnerQueriesCount(ctx, previousOwner, k.GetOwnerQueriesCount(ctx, previousOwner)-1)
+			store.Delete(types.GetQueryByOwnerKey(previousOwner, query.Id))
+		}
+		k.setOwnerQueriesCount(ctx, query.Owner, k.GetOwnerQueriesCount(ctx, query.Owner)+1)
+		store.Set(types.GetQueryByOwnerKey(query.Owner, query.Id), []byte{})
+	}
+	if previousConnectionID != query.ConnectionId {
+		if previousConnectionID != "" {
+			store.Delete(types.GetQueryByConnectionKey(previousConnectionID, query.Id))
+		}
+		store.Set(types.GetQueryByConnectionKey(query.ConnectionId, query.Id), []byte{})
+	}
+
 	store.Set(types.GetRegisteredQueryByIDKey(query.Id), bz)
 	k.Logger(ctx).Debug("SaveQuery successful", "query", query)
 
@@ -113,33 +141,108 @@ func (k Keeper) GetQueryByID(ctx sdk.Context, id uint64) (*types.RegisteredQuery
 
 func (k Keeper) RemoveQueryByID(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
+
+	if query, err := k.GetQueryByID(ctx, id); err == nil {
+		k.setOwnerQueriesCount(ctx, query.Owner, k.GetOwnerQueriesCount(ctx, query.Owner)-1)
+		store.Delete(types.GetQueryByOwnerKey(query.Owner, id))
+		store.Delete(types.GetQueryByConnectionKey(query.ConnectionId, id))
+	}
+
 	store.Delete(types.GetRegisteredQueryByIDKey(id))
 }
 
-func (k Keeper) SaveKVQueryResult(ctx sdk.Context, id uint64, result *types.QueryResult) error {
+// GetOwnerQueriesCount returns the amount of the registered queries owned by the owner.
+func (k Keeper) GetOwnerQueriesCount(ctx sdk.Context, owner string) uint64 {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOwnerQueriesCountKey(owner))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setOwnerQueriesCount(ctx sdk.Context, owner string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetOwnerQueriesCountKey(owner))
+		return
+	}
+	store.Set(types.GetOwnerQueriesCountKey(owner), sdk.Uint64ToBigEndian(count))
+}
+
+// RebuildQueryIndexes drops the owner queries counts and the queries by owner and by connection indexes
+// and builds them again from the registered queries. It is used to restore the indexes from genesis.
+func (k Keeper) RebuildQueryIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefixKey := range [][]byte{types.OwnerQueriesCountKey, types.QueryByOwnerKey, types.QueryByConnectionKey} {
+		iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	counts := make(map[string]uint64)
+	var owners []string
+	k.IterateRegisteredQueries(ctx, func(_ int64, query types.RegisteredQuery) (stop bool) {
+		if _, ok := counts[query.Owner]; !ok {
+			owners = append(owners, query.Owner)
+		}
+		counts[query.Owner]++
+		store.Set(types.GetQueryByOwnerKey(query.Owner, query.Id), []byte{})
+		store.Set(types.GetQueryByConnectionKey(query.ConnectionId, query.Id), []byte{})
+		return false
+	})
+
+	for _, owner := range owners {
+		k.setOwnerQueriesCount(ctx, owner, counts[owner])
+	}
+}
 
+func (k Keeper) SaveKVQueryResult(ctx sdk.Context, id uint64, result *types.QueryResult) error {
 	if result.KvResults != nil {
 		cleanResult := clearQueryResult(result)
-		bz, err := k.cdc.Marshal(&cleanResult)
-		if err != nil {
-			return sdkerrors.Wrapf(types.ErrProtoMarshal, "failed to marshal result result: %v", err)
+		if err := k.SetQueryResult(ctx, id, &cleanResult); err != nil {
+			return err
 		}
 
-		store.Set(types.GetRegisteredQueryResultByIDKey(id), bz)
-
-		if err = k.UpdateLastRemoteHeight(ctx, id, result.Height); err != nil {
+		if err := k.UpdateLastRemoteHeight(ctx, id, ibcclienttypes.NewHeight(result.Revision, result.Height)); err != nil {
 			return sdkerrors.Wrapf(err, "failed to update last remote height for a result with id %d: %v", id, err)
 		}
 
-		if err = k.UpdateLastLocalHeight(ctx, id, uint64(ctx.BlockHeight())); err != nil {
+		if err := k.UpdateLastLocalHeight(ctx, id, uint64(ctx.BlockHeight())); err != nil {
 			return sdkerrors.Wrapf(err, "failed to update last local height for a result with id %d: %v", id, err)
 		}
+
+		if err := k.saveQueryResultToHistory(ctx, id, &cleanResult); err != nil {
+			return sdkerrors.Wrapf(err, "failed to save result history for a result with id %d: %v", id, err)
+		}
 	}
 	k.Logger(ctx).Debug("Successfully saved query result", "result", &result)
 	return nil
 }
 
+// SetQueryResult stores the result for the query with id as is, without touching the last submitted
+// heights of the query. It is used to restore query results from genesis.
+func (k Keeper) SetQueryResult(ctx sdk.Context, id uint64, result *types.QueryResult) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(result)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrProtoMarshal, "failed to marshal result result: %v", err)
+	}
+
+	store.Set(types.GetRegisteredQueryResultByIDKey(id), bz)
+
+	return nil
+}
+
 // SaveTransactionAsProcessed simply stores a key (SubmittedTxKey + bigEndianBytes(queryID) + tx_hash) with
 // mock data. This key can be used to check whether a certain transaction was already submitted for a specific
 // transaction query.
@@ -157,6 +260,24 @@ func (k Keeper) CheckTransactionIsAlreadyProcessed(ctx sdk.Context, queryID uint
 	return store.Has(key)
 }
 
+// IterateSubmittedTransactions iterates over all the transactions saved as processed by TX queries.
+func (k Keeper) IterateSubmittedTransactions(ctx sdk.Context, fn func(queryID uint64, txHash []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubmittedTxKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) <= 8 {
+			continue
+		}
+
+		if fn(sdk.BigEndianToUint64(key[:8]), key[8:]) {
+			break
+		}
+	}
+}
+
 // GetQueryResultByID returns a QueryResult for query with id
 func (k Keeper) GetQueryResultByID(ctx sdk.Context, id uint64) (*types.QueryResult, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -179,6 +300,20 @@ func (k Keeper) removeQueryResultByID(ctx sdk.Context, id uint64) {
 	store.Delete(types.GetRegisteredQueryResultByIDKey(id))
 }
 
+// removeQuery removes the query and everything stored for it.
+func (k Keeper) removeQuery(ctx sdk.Context, query *types.RegisteredQuery) {
+	k.RemoveQueryByID(ctx, query.Id)
+	k.removeSudoFailures(ctx, query.Id)
+	if types.InterchainQueryType(query.GetQueryType()).IsKV() {
+		k.removeQueryResultByID(ctx, query.Id)
+		k.removeQueryResultHistory(ctx, query.Id)
+		k.removeSoloMachineSequences(ctx, query.Id)
+	}
+	if types.InterchainQueryType(query.GetQueryType()).IsTX() {
+		k.MarkTxQueryToRemove(ctx, query.Id)
+	}
+}
+
 func (k Keeper) UpdateLastLocalHeight(ctx sdk.Context, queryID uint64, newLocalHeight uint64) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -197,7 +332,9 @@ func (k Keeper) UpdateLastLocalHeight(ctx sdk.Context, queryID uint64, newLocalH
 	return k.SaveQuery(ctx, query)
 }
 
-func (k Keeper) UpdateLastRemoteHeight(ctx sdk.Context, queryID uint64, newRemoteHeight uint64) error {
+// UpdateLastRemoteHeight sets the remote height of the last submitted result of the query. The new height
+// must be greater than the previous one, the heights of a newer revision are always greater.
+func (k Keeper) UpdateLastRemoteHeight(ctx sdk.Context, queryID uint64, newRemoteHeight ibcclienttypes.Height) error {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(types.GetRegisteredQueryByIDKey(queryID))
@@ -210,8 +347,8 @@ func (k Keeper) UpdateLastRemoteHeight(ctx sdk.Context, queryID uint64, newRemot
 		return sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unmarshal registered query: %v", err)
 	}
 
-	if query.LastSubmittedResultRemoteHeight >= newRemoteHeight {
-		return sdkerrors.Wrapf(types.ErrInvalidHeight, "can't save query result for height %d: result height can't be less or equal then last submitted query result height %d", newRemoteHeight, query.LastSubmittedResultRemoteHeight)
+	if !query.LastSubmittedResultRemoteHeight.LT(newRemoteHeight) {
+		return sdkerrors.Wrapf(types.ErrInvalidHeight, "can't save query result for height %s: result height can't be less or equal then last submitted query result height %s", newRemoteHeight, query.LastSubmittedResultRemoteHeight)
 	}
 
 	query.LastSubmittedResultRemoteHeight = newRemoteHeight
@@ -256,6 +393,7 @@ func clearQueryResult(result *types.QueryResult) types.QueryResult {
 		KvResults: storageValues,
 		Block:     nil,
 		Height:    result.Height,
+		Revision:  result.Revision,
 	}
 
 	return cleanResult
@@ -267,18 +405,84 @@ func (k Keeper) checkRegisteredQueryExists(ctx sdk.Context, id uint64) bool {
 	return store.Has(types.GetRegisteredQueryByIDKey(id))
 }
 
-func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (*tendermintLightClientTypes.ClientState, error) {
-	clientStateResponse, ok := k.ibcKeeper.ClientKeeper.GetClientState(ctx, clientID)
+func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, error) {
+	clientState, ok := k.ibcKeeper.ClientKeeper.GetClientState(ctx, clientID)
 	if !ok {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidClientID, "could not find a ClientState with client id: %s", clientID)
 	}
 
-	clientState, ok := clientStateResponse.(*tendermintLightClientTypes.ClientState)
+	return clientState, nil
+}
+
+// GetLastResultRemoteTimestamp returns the remote chain time, in nanoseconds, of the last submitted KV result of
+// the query. The time is taken from the consensus state of the query connection client the result has been
+// verified against, so it isn't known once the consensus state is pruned or the query is moved to another
+// connection.
+func (k Keeper) GetLastResultRemoteTimestamp(ctx sdk.Context, query *types.RegisteredQuery) (uint64, error) {
+	if query.LastSubmittedResultRemoteHeight.IsZero() {
+		return 0, sdkerrors.Wrapf(types.ErrNoQueryResult, "no results have been submitted for query %d over connection %s", query.Id, query.ConnectionId)
+	}
+
+	connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
 	if !ok {
-		return nil, sdkerrors.Wrapf(ibcclienttypes.ErrInvalidClientType, "cannot cast ClientState interface into ClientState type")
+		return 0, sdkerrors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s'", query.ConnectionId)
 	}
 
-	return clientState, nil
+	clientState, verifier, err := k.getProofVerifier(ctx, connection.ClientId)
+	if err != nil {
+		return 0, err
+	}
+
+	return verifier.ConsensusTimestamp(ctx, connection.ClientId, clientState, query.LastSubmittedResultRemoteHeight)
+}
+
+// CheckLastResultCurrent returns the types.ErrStaleQueryResult error if the last result of the query obtained at the
+// remote height doesn't match the current state of the query: the result has been submitted over the previous
+// connection of the query, or the remote chain has been upgraded to a new revision since the result was obtained.
+func (k Keeper) CheckLastResultCurrent(ctx sdk.Context, query *types.RegisteredQuery, height ibcclienttypes.Height) error {
+	if !query.LastSubmittedResultRemoteHeight.EQ(height) {
+		return sdkerrors.Wrapf(types.ErrStaleQueryResult, "result of query %d obtained at height %s doesn't belong to connection %s",
+			query.Id, height, query.ConnectionId)
+	}
+
+	connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s'", query.ConnectionId)
+	}
+
+	clientState, err := k.GetClientState(ctx, connection.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if revision := clientState.GetLatestHeight().GetRevisionNumber(); revision != height.RevisionNumber {
+		return sdkerrors.Wrapf(types.ErrStaleQueryResult, "result of query %d obtained at revision %d, the remote chain is at revision %d",
+			query.Id, height.RevisionNumber, revision)
+	}
+
+	return nil
+}
+
+// updateClientWithHeader updates the IBC client with the header of the remote chain the KV results obtained at
+// the height are verified against. The header must be for height + 1, since the app hash of a block is
+// committed to in the header of the next block.
+func (k Keeper) updateClientWithHeader(ctx sdk.Context, clientID string, headerAny *codectypes.Any, revision uint64, height uint64) error {
+	header, err := ibcclienttypes.UnpackHeader(headerAny)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack header: %v", err)
+	}
+
+	expectedHeight := ibcclienttypes.NewHeight(revision, height+1)
+	if !header.GetHeight().EQ(expectedHeight) {
+		return sdkerrors.Wrapf(types.ErrInvalidHeader, "header height %s is not equal to the result height + 1 (%s)",
+			header.GetHeight(), expectedHeight)
+	}
+
+	if err := k.ibcKeeper.ClientKeeper.UpdateClient(ctx, clientID, header); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidHeader, "failed to update client: %v", err)
+	}
+
+	return nil
 }
 
 func (k *Keeper) CollectDeposit(ctx sdk.Context, queryInfo types.RegisteredQuery) error {
@@ -295,6 +499,50 @@ func (k *Keeper) CollectDeposit(ctx sdk.Context, queryInfo types.RegisteredQuery
 	return nil
 }
 
+// adjustDeposit changes the deposit of the query by the per key deposit for the difference between the
+// new and the old amounts of keys: the coins for the added keys are collected from the query owner and the
+// coins for the removed keys are returned to the owner. The rest of the deposit stays as it was paid on the
+// query registration.
+func (k Keeper) adjustDeposit(ctx sdk.Context, query *types.RegisteredQuery, perKeyDeposit sdk.Coins, oldKeysCount, newKeysCount uint64) error {
+	owner, err := query.GetOwnerAddress()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case newKeysCount > oldKeysCount:
+		toCollect := depositForKeys(perKeyDeposit, newKeysCount-oldKeysCount)
+		if toCollect.IsZero() {
+			return nil
+		}
+		if err := k.bank.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, toCollect); err != nil {
+			return err
+		}
+		query.Deposit = query.Deposit.Add(toCollect...)
+	case newKeysCount < oldKeysCount:
+		// the refund can't exceed the deposit, e.g. if the per key deposit has been raised since the registration
+		toRefund := sdk.NewCoins()
+		for _, coin := range depositForKeys(perKeyDeposit, oldKeysCount-newKeysCount) {
+			toRefund = toRefund.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, query.Deposit.AmountOf(coin.Denom))))
+		}
+		if toRefund.IsZero() {
+			return nil
+		}
+		k.MustPayOutDeposit(ctx, toRefund, owner)
+		query.Deposit = query.Deposit.Sub(toRefund)
+	}
+
+	return nil
+}
+
+func depositForKeys(perKeyDeposit sdk.Coins, keysCount uint64) sdk.Coins {
+	deposit := sdk.NewCoins()
+	for _, coin := range perKeyDeposit {
+		deposit = deposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(keysCount))))
+	}
+	return deposit
+}
+
 func (k Keeper) MustPayOutDeposit(ctx sdk.Context, deposit sdk.Coins, sender sdk.AccAddress) {
 	err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, deposit)
 	if err != nil {
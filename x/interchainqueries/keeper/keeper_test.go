@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -833,7 +834,7 @@ func (suite *KeeperTestSuite) TestSubmitInterchainQueryResult() {
 				suite.NoError(suite.Path.EndpointA.UpdateClient())
 
 				// pretend like we have a very new query result
-				suite.NoError(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper.UpdateLastRemoteHeight(ctx, res.Id, 9999))
+				suite.NoError(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper.UpdateLastRemoteHeight(ctx, res.Id, ibcclienttypes.NewHeight(suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(), 9999)))
 
 				resp := suite.ChainB.App.Query(abci.RequestQuery{
 					Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
@@ -862,6 +863,57 @@ func (suite *KeeperTestSuite) TestSubmitInterchainQueryResult() {
 			},
 			iqtypes.ErrInvalidHeight,
 		},
+		{
+			"query is not due for update",
+			func(sender string, ctx sdktypes.Context) {
+				clientKey := host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+
+				registerMsg := iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId: suite.Path.EndpointA.ConnectionID,
+					Keys: []*iqtypes.KVKey{
+						{Path: host.StoreKey, Key: clientKey},
+					},
+					QueryType:    string(iqtypes.InterchainQueryTypeKV),
+					UpdatePeriod: 10,
+					Sender:       sender,
+				}
+
+				msgSrv := keeper.NewMsgServerImpl(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper)
+
+				res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &registerMsg)
+				suite.Require().NoError(err)
+
+				suite.NoError(suite.Path.EndpointA.UpdateClient())
+
+				// pretend like the query result has just been submitted
+				suite.NoError(suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper.UpdateLastLocalHeight(ctx, res.Id, uint64(ctx.BlockHeight())))
+
+				resp := suite.ChainB.App.Query(abci.RequestQuery{
+					Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+					Height: suite.ChainB.LastHeader.Header.Height - 1,
+					Data:   clientKey,
+					Prove:  true,
+				})
+
+				msg = iqtypes.MsgSubmitQueryResult{
+					QueryId:  res.Id,
+					Sender:   sender,
+					ClientId: suite.Path.EndpointA.ClientID,
+					Result: &iqtypes.QueryResult{
+						KvResults: []*iqtypes.StorageValue{{
+							Key:           resp.Key,
+							Proof:         resp.ProofOps,
+							Value:         resp.Value,
+							StoragePrefix: host.StoreKey,
+						}},
+						Block:    nil,
+						Height:   uint64(resp.Height),
+						Revision: suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+					},
+				}
+			},
+			iqtypes.ErrQueryNotDueForUpdate,
+		},
 	}
 
 	for i, tc := range tests {
@@ -903,6 +955,690 @@ func (suite *KeeperTestSuite) TestSubmitInterchainQueryResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitInterchainQueryResults() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		clientKey     = host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	)
+
+	// Store code and instantiate reflect contract.
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	register := func(queryType iqtypes.InterchainQueryType) uint64 {
+		suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+		msg := iqtypes.MsgRegisterInterchainQuery{
+			ConnectionId: suite.Path.EndpointA.ConnectionID,
+			QueryType:    string(queryType),
+			UpdatePeriod: 1,
+			Sender:       contractAddress.String(),
+		}
+		if queryType.IsKV() {
+			msg.Keys = []*iqtypes.KVKey{{Path: host.StoreKey, Key: clientKey}}
+		} else {
+			msg.TransactionsFilter = "[]"
+		}
+
+		res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &msg)
+		suite.Require().NoError(err)
+		return res.Id
+	}
+	validQueryID := register(iqtypes.InterchainQueryTypeKV)
+	invalidProofQueryID := register(iqtypes.InterchainQueryTypeKV)
+	txQueryID := register(iqtypes.InterchainQueryTypeTX)
+
+	suite.Require().NoError(suite.Path.EndpointA.UpdateClient())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	resp := suite.ChainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: suite.ChainB.LastHeader.Header.Height - 1,
+		Data:   clientKey,
+		Prove:  true,
+	})
+	kvResults := func(value []byte) []*iqtypes.StorageValue {
+		return []*iqtypes.StorageValue{{
+			Key:           resp.Key,
+			Proof:         resp.ProofOps,
+			Value:         value,
+			StoragePrefix: host.StoreKey,
+		}}
+	}
+
+	res, err := msgSrv.SubmitQueryResults(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResults{
+		Sender:   contractOwner.String(),
+		ClientId: suite.Path.EndpointA.ClientID,
+		Revision: suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+		Height:   uint64(resp.Height),
+		Results: []iqtypes.KVQueryResult{
+			{QueryId: validQueryID, KvResults: kvResults(resp.Value)},
+			{QueryId: invalidProofQueryID, KvResults: kvResults([]byte("some evil data"))},
+			{QueryId: txQueryID, KvResults: kvResults(resp.Value)},
+			{QueryId: 100, KvResults: kvResults(resp.Value)},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 4)
+
+	expected := []struct {
+		queryID uint64
+		success bool
+		err     error
+	}{
+		{validQueryID, true, nil},
+		{invalidProofQueryID, false, iqtypes.ErrInvalidProof},
+		{txQueryID, false, iqtypes.ErrInvalidType},
+		{100, false, iqtypes.ErrInvalidQueryID},
+	}
+	for i, e := range expected {
+		suite.Require().Equal(e.queryID, res.Results[i].QueryId)
+		suite.Require().Equal(e.success, res.Results[i].Success)
+		if e.err != nil {
+			suite.Require().Contains(res.Results[i].Error, e.err.Error())
+		} else {
+			suite.Require().Empty(res.Results[i].Error)
+		}
+	}
+
+	// only the successfully verified result is saved
+	result, err := iqkeeper.GetQueryResultByID(ctx, validQueryID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(resp.Value, result.KvResults[0].Value)
+
+	query, err := iqkeeper.GetQueryByID(ctx, validQueryID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcclienttypes.NewHeight(suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(), uint64(resp.Height)), query.LastSubmittedResultRemoteHeight)
+
+	_, err = iqkeeper.GetQueryResultByID(ctx, invalidProofQueryID)
+	suite.Require().ErrorIs(err, iqtypes.ErrNoQueryResult)
+
+	query, err = iqkeeper.GetQueryByID(ctx, invalidProofQueryID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcclienttypes.ZeroHeight(), query.LastSubmittedResultRemoteHeight)
+
+	// consensus state must exist for the submitted height
+	_, err = msgSrv.SubmitQueryResults(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResults{
+		Sender:   contractOwner.String(),
+		ClientId: suite.Path.EndpointA.ClientID,
+		Revision: suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+		Height:   uint64(resp.Height) + 100,
+		Results:  []iqtypes.KVQueryResult{{QueryId: invalidProofQueryID, KvResults: kvResults(resp.Value)}},
+	})
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrConsensusStateNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSubmitKVQueryResultWithHeader() {
+	suite.SetupTest()
+
+	var (
+		ctx       = suite.ChainA.GetContext()
+		owner     = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper  = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv    = keeper.NewMsgServerImpl(iqkeeper)
+		clientKey = host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: host.StoreKey, Key: clientKey}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the light client on chain A doesn't know the new header of chain B yet
+	suite.Coordinator.CommitBlock(suite.ChainB)
+	header, err := suite.ChainA.ConstructUpdateTMClientHeader(suite.ChainB, suite.Path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	headerAny, err := ibcclienttypes.PackHeader(header)
+	suite.Require().NoError(err)
+
+	resp := suite.ChainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: header.Header.Height - 1,
+		Data:   clientKey,
+		Prove:  true,
+	})
+
+	submit := func(headerAny *codectypes.Any) error {
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   senderAddress.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result: &iqtypes.QueryResult{
+				KvResults: []*iqtypes.StorageValue{{
+					Key:           resp.Key,
+					Proof:         resp.ProofOps,
+					Value:         resp.Value,
+					StoragePrefix: host.StoreKey,
+				}},
+				Height:   uint64(resp.Height),
+				Revision: header.GetHeight().GetRevisionNumber(),
+				Header:   headerAny,
+			},
+		})
+		return err
+	}
+
+	err = submit(nil)
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrConsensusStateNotFound)
+
+	// the header must be exactly for the height next to the proof height
+	resp.Height--
+	err = submit(headerAny)
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidHeader)
+	resp.Height++
+
+	err = submit(headerAny)
+	suite.Require().NoError(err)
+
+	result, err := iqkeeper.GetQueryResultByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(resp.Height), result.Height)
+	suite.Require().Equal(resp.Value, result.KvResults[0].Value)
+
+	clientState, found := suite.GetNeutronZoneApp(suite.ChainA).IBCKeeper.ClientKeeper.GetClientState(ctx, suite.Path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(header.GetHeight(), clientState.GetLatestHeight())
+}
+
+func (suite *KeeperTestSuite) TestTransferInterchainQueryOwnership() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = neutronApp.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		txHash        = []byte("tx hash")
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	oldOwner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	newOwner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, oldOwner)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             oldOwner.String(),
+	})
+	suite.Require().NoError(err)
+	iqkeeper.SaveTransactionAsProcessed(ctx, res.Id, txHash)
+
+	registeredQuery, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+
+	transfer := func(sender, owner sdktypes.AccAddress) error {
+		_, err := msgSrv.TransferInterchainQueryOwnership(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgTransferInterchainQueryOwnership{
+			QueryId:  res.Id,
+			NewOwner: owner.String(),
+			Sender:   sender.String(),
+		})
+		return err
+	}
+
+	// only the owner can transfer the query
+	suite.Require().ErrorIs(transfer(newOwner, newOwner), sdkerrors.ErrUnauthorized)
+	// a TX query can be transferred to a contract only
+	suite.Require().ErrorIs(transfer(oldOwner, senderAddress), iqtypes.ErrNotContract)
+
+	// the transfer to the current owner is rejected as such rather than by the owner quota the owner is at
+	params := iqkeeper.GetParams(ctx)
+	params.MaxActiveQueriesPerOwner = 1
+	iqkeeper.SetParams(ctx, params)
+	suite.Require().ErrorIs(transfer(oldOwner, oldOwner), sdkerrors.ErrInvalidRequest)
+	params.MaxActiveQueriesPerOwner = iqtypes.DefaultMaxActiveQueriesPerOwner
+	iqkeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	suite.Require().NoError(transfer(oldOwner, newOwner))
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueQueryOwnershipTransferred))
+
+	// the query is kept as is except for the owner
+	transferredQuery, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	registeredQuery.Owner = newOwner.String()
+	suite.Require().Equal(registeredQuery, transferredQuery)
+	suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, txHash))
+
+	// the previous owner has no control over the query anymore, the deposit goes to the new owner on removal
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: res.Id,
+		Sender:  oldOwner.String(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: res.Id,
+		Sender:  newOwner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(registeredQuery.Deposit, neutronApp.BankKeeper.GetAllBalances(ctx, newOwner))
+}
+
+func (suite *KeeperTestSuite) TestExternallyOwnedQueries() {
+	suite.SetupTest()
+
+	var (
+		ctx        = suite.ChainA.GetContext()
+		owner      = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper   = neutronApp.InterchainQueriesKeeper
+		msgSrv     = keeper.NewMsgServerImpl(iqkeeper)
+		clientKey  = host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	// TX query results are delivered to the owner only, so an account which is not a contract can't own them
+	_, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             owner.String(),
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrNotContract)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: host.StoreKey, Key: clientKey}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Path.EndpointA.UpdateClient())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	resp := suite.ChainB.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: suite.ChainB.LastHeader.Header.Height - 1,
+		Data:   clientKey,
+		Prove:  true,
+	})
+
+	// the callback is requested but skipped, the result is stored only
+	_, err = msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+		QueryId:  res.Id,
+		Sender:   senderAddress.String(),
+		ClientId: suite.Path.EndpointA.ClientID,
+		Result: &iqtypes.QueryResult{
+			KvResults: []*iqtypes.StorageValue{{
+				Key:           resp.Key,
+				Proof:         resp.ProofOps,
+				Value:         resp.Value,
+				StoragePrefix: host.StoreKey,
+			}},
+			Height:           uint64(resp.Height),
+			Revision:         suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+			AllowKvCallbacks: true,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), iqkeeper.GetLastSudoFailureID(ctx))
+
+	result, err := iqkeeper.GetQueryResultByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(resp.Height), result.Height)
+	suite.Require().Equal(resp.Value, result.KvResults[0].Value)
+
+	// the owner manages the query as a contract would
+	balance := neutronApp.BankKeeper.GetAllBalances(ctx, owner)
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: res.Id,
+		Sender:  owner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance.Add(iqtypes.DefaultQueryDeposit...), neutronApp.BankKeeper.GetAllBalances(ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestUpdateTxQueryFilterAndConnection() {
+	suite.SetupTest()
+
+	// the connection the query is moved to
+	newPath := testutil.NewICAPath(suite.ChainA, suite.ChainB)
+	suite.Coordinator.SetupConnections(newPath)
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		txHash        = []byte("tx hash")
+		newFilter     = `[{"field":"transfer.recipient","op":"eq","value":"cosmos1"}]`
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	owner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             owner.String(),
+	})
+	suite.Require().NoError(err)
+	iqkeeper.SaveTransactionAsProcessed(ctx, res.Id, txHash)
+	suite.Require().NoError(iqkeeper.UpdateLastRemoteHeight(ctx, res.Id, ibcclienttypes.NewHeight(0, 10)))
+
+	update := func(msg iqtypes.MsgUpdateInterchainQueryRequest) error {
+		msg.QueryId = res.Id
+		msg.Sender = owner.String()
+		_, err := msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &msg)
+		return err
+	}
+
+	// keys can't be set for a TX query
+	err = update(iqtypes.MsgUpdateInterchainQueryRequest{NewKeys: []*iqtypes.KVKey{{Path: host.StoreKey, Key: []byte("key")}}})
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryType)
+
+	err = update(iqtypes.MsgUpdateInterchainQueryRequest{NewConnectionId: "unknown"})
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidConnectionID)
+
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	suite.Require().NoError(update(iqtypes.MsgUpdateInterchainQueryRequest{NewTransactionsFilter: newFilter}))
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueQueryUpdated))
+
+	query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFilter, query.TransactionsFilter)
+	// the processed transactions are kept while the connection is the same
+	suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, txHash))
+
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	suite.Require().NoError(update(iqtypes.MsgUpdateInterchainQueryRequest{NewConnectionId: newPath.EndpointA.ConnectionID}))
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueQueryUpdated))
+
+	query, err = iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newPath.EndpointA.ConnectionID, query.ConnectionId)
+	suite.Require().Equal(newFilter, query.TransactionsFilter)
+	suite.Require().Equal(ibcclienttypes.ZeroHeight(), query.LastSubmittedResultRemoteHeight)
+
+	// the processed transactions of the previous connection are removed in batches, no results are accepted
+	// until then
+	suite.Require().True(iqkeeper.IsTxQueryToRemove(ctx, res.Id))
+	_, err = msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+		QueryId:  res.Id,
+		Sender:   owner.String(),
+		ClientId: newPath.EndpointA.ClientID,
+		Result:   &iqtypes.QueryResult{Block: &iqtypes.Block{Txs: []*iqtypes.TxValue{{}}}},
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrTxQueryToRemove)
+
+	iqkeeper.RemoveProcessedTransactions(ctx)
+	suite.Require().False(iqkeeper.IsTxQueryToRemove(ctx, res.Id))
+	suite.Require().False(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, txHash))
+}
+
+func (suite *KeeperTestSuite) TestUpdateKVQueryConnection() {
+	suite.SetupTest()
+
+	// the connection the query is moved to
+	newPath := testutil.NewICAPath(suite.ChainA, suite.ChainB)
+	suite.Coordinator.SetupConnections(newPath)
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		result        = &iqtypes.QueryResult{
+			KvResults: []*iqtypes.StorageValue{{StoragePrefix: host.StoreKey, Key: []byte("key"), Value: []byte("value")}},
+			Height:    10,
+		}
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	owner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: host.StoreKey, Key: []byte("key")}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(iqkeeper.SetQueryResult(ctx, res.Id, result))
+	suite.Require().NoError(iqkeeper.SetQueryResultHistoryRecord(ctx, res.Id, result))
+
+	_, err = msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId:         res.Id,
+		NewConnectionId: newPath.EndpointA.ConnectionID,
+		Sender:          owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the result and the history of the previous counterparty are gone
+	_, err = iqkeeper.GetQueryResultByID(ctx, res.Id)
+	suite.Require().ErrorIs(err, iqtypes.ErrNoQueryResult)
+	history, err := iqkeeper.GetQueryResultHistory(ctx, res.Id, 0, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().Empty(history)
+}
+
+func (suite *KeeperTestSuite) TestQueryQuotas() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		key           = &iqtypes.KVKey{Path: host.StoreKey, Key: []byte("key")}
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	owner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	anotherOwner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	suite.TopUpWallet(ctx, senderAddress, anotherOwner)
+
+	params := iqkeeper.GetParams(ctx)
+	params.MaxKvQueryKeysCount = 1
+	params.MaxActiveQueriesPerOwner = 1
+	params.MaxTransactionsFilterLength = 10
+	iqkeeper.SetParams(ctx, params)
+
+	register := func(sender sdktypes.AccAddress, msg iqtypes.MsgRegisterInterchainQuery) (uint64, error) {
+		msg.ConnectionId = suite.Path.EndpointA.ConnectionID
+		msg.UpdatePeriod = 1
+		msg.Sender = sender.String()
+		res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &msg)
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	_, err := register(owner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType: string(iqtypes.InterchainQueryTypeKV),
+		Keys:      []*iqtypes.KVKey{key, key},
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrTooManyKVQueryKeys)
+
+	_, err = register(owner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		TransactionsFilter: `[{"field":"tx.height","op":"gt","value":1}]`,
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidTransactionsFilter)
+
+	queryID, err := register(owner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType: string(iqtypes.InterchainQueryTypeKV),
+		Keys:      []*iqtypes.KVKey{key},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), iqkeeper.GetOwnerQueriesCount(ctx, owner.String()))
+
+	// the owner has reached the limit of active queries
+	_, err = register(owner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		TransactionsFilter: "[]",
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrTooManyActiveQueries)
+
+	_, err = msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId: queryID,
+		NewKeys: []*iqtypes.KVKey{key, key},
+		Sender:  owner.String(),
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrTooManyKVQueryKeys)
+
+	// the query can't be transferred to an owner which has reached the limit too
+	_, err = register(anotherOwner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType: string(iqtypes.InterchainQueryTypeKV),
+		Keys:      []*iqtypes.KVKey{key},
+	})
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.TransferInterchainQueryOwnership(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgTransferInterchainQueryOwnership{
+		QueryId:  queryID,
+		NewOwner: anotherOwner.String(),
+		Sender:   owner.String(),
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrTooManyActiveQueries)
+
+	// the removed query frees the quota
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: queryID,
+		Sender:  owner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), iqkeeper.GetOwnerQueriesCount(ctx, owner.String()))
+
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	_, err = register(owner, iqtypes.MsgRegisterInterchainQuery{
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		TransactionsFilter: "[]",
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestScaledQueryDeposit() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = neutronApp.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		key           = &iqtypes.KVKey{Path: host.StoreKey, Key: []byte("key")}
+		coins         = func(amount int64) sdktypes.Coins {
+			return sdktypes.NewCoins(sdktypes.NewCoin(sdktypes.DefaultBondDenom, sdktypes.NewInt(amount)))
+		}
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	owner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	params := iqkeeper.GetParams(ctx)
+	params.QueryDeposit = coins(1000)
+	params.QueryDepositPerKey = coins(100)
+	params.TxQueryDepositSurcharge = coins(500)
+	iqkeeper.SetParams(ctx, params)
+
+	estimate := func(queryType iqtypes.InterchainQueryType, keysCount uint64) sdktypes.Coins {
+		res, err := iqkeeper.DepositEstimate(sdktypes.WrapSDKContext(ctx), &iqtypes.QueryDepositEstimateRequest{
+			QueryType: string(queryType),
+			KeysCount: keysCount,
+		})
+		suite.Require().NoError(err)
+		return res.Deposit
+	}
+	suite.Require().Equal(coins(1200), estimate(iqtypes.InterchainQueryTypeKV, 2))
+	suite.Require().Equal(coins(1500), estimate(iqtypes.InterchainQueryTypeTX, 0))
+
+	balance := func() sdktypes.Int {
+		return neutronApp.BankKeeper.GetBalance(ctx, owner, sdktypes.DefaultBondDenom).Amount
+	}
+	initialBalance := balance()
+
+	kvQuery, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{key, key},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.SubRaw(1200), balance())
+
+	_, err = msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             owner.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.SubRaw(2700), balance())
+
+	update := func(keys ...*iqtypes.KVKey) {
+		_, err := msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+			QueryId: kvQuery.Id,
+			NewKeys: keys,
+			Sender:  owner.String(),
+		})
+		suite.Require().NoError(err)
+	}
+
+	// the base deposit is paid on the registration and isn't re-priced on the keys update
+	params.QueryDeposit = coins(5000)
+	iqkeeper.SetParams(ctx, params)
+
+	// more keys, the difference is collected
+	update(key, key, key, key)
+	suite.Require().Equal(initialBalance.SubRaw(2900), balance())
+
+	// fewer keys, the difference is refunded
+	update(key)
+	suite.Require().Equal(initialBalance.SubRaw(2600), balance())
+
+	query, err := iqkeeper.GetQueryByID(ctx, kvQuery.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(1100), query.Deposit)
+}
+
 func (suite *KeeperTestSuite) TopUpWallet(ctx sdktypes.Context, sender sdktypes.AccAddress, contractAddress sdktypes.AccAddress) {
 	coinsAmnt := sdktypes.NewCoins(sdktypes.NewCoin(sdktypes.DefaultBondDenom, sdktypes.NewInt(int64(1_000_000))))
 	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
@@ -912,3 +1648,26 @@ func (suite *KeeperTestSuite) TopUpWallet(ctx sdktypes.Context, sender sdktypes.
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestUpdateLastRemoteHeight() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+	)
+
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{Id: 1, QueryType: string(iqtypes.InterchainQueryTypeKV)}))
+	suite.Require().NoError(iqkeeper.UpdateLastRemoteHeight(ctx, 1, ibcclienttypes.NewHeight(1, 100)))
+
+	// results can't go back in height within the same revision
+	suite.Require().ErrorIs(iqkeeper.UpdateLastRemoteHeight(ctx, 1, ibcclienttypes.NewHeight(1, 100)), iqtypes.ErrInvalidHeight)
+	suite.Require().ErrorIs(iqkeeper.UpdateLastRemoteHeight(ctx, 1, ibcclienttypes.NewHeight(1, 50)), iqtypes.ErrInvalidHeight)
+
+	// the heights of a new revision restart from the beginning after the remote chain upgrade
+	suite.Require().NoError(iqkeeper.UpdateLastRemoteHeight(ctx, 1, ibcclienttypes.NewHeight(2, 1)))
+
+	query, err := iqkeeper.GetQueryByID(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcclienttypes.NewHeight(2, 1), query.LastSubmittedResultRemoteHeight)
+}
@@ -6,13 +6,12 @@ import (
 	"strconv"
 	"time"
 
-	ics23 "github.com/confio/ics23/go"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
-	ibccommitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 
 	"github.com/neutron-org/neutron/x/interchainqueries/types"
 )
@@ -39,9 +38,11 @@ func (k msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.Msg
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
 	}
 
-	if !k.wasmKeeper.HasContractInfo(ctx, senderAddr) {
+	// KV queries can be owned by externally owned accounts: their results are stored to be read via gRPC,
+	// but nobody is called back. TX query results aren't stored, so the owner must be a contract.
+	if types.InterchainQueryType(msg.QueryType).IsTX() && !k.wasmKeeper.HasContractInfo(ctx, senderAddr) {
 		k.Logger(ctx).Debug("RegisterInterchainQuery: contract not found", "sender_address", msg.Sender)
-		return nil, sdkerrors.Wrapf(types.ErrNotContract, "%s is not a contract address", msg.Sender)
+		return nil, sdkerrors.Wrapf(types.ErrNotContract, "only contracts can register TX queries, %s is not a contract address", msg.Sender)
 	}
 
 	if _, err := k.ibcKeeper.ConnectionKeeper.Connection(goCtx, &ibcconnectiontypes.QueryConnectionRequest{ConnectionId: msg.ConnectionId}); err != nil {
@@ -49,11 +50,37 @@ func (k msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s': %v", msg.ConnectionId, err)
 	}
 
+	if err := k.checkConnectionClientActive(ctx, msg.ConnectionId); err != nil {
+		ctx.Logger().Debug("RegisterInterchainQuery: connection client is not active", "message", msg, "error", err)
+		return nil, err
+	}
+
 	lastID := k.GetLastRegisteredQueryKey(ctx)
 	lastID += 1
 
 	params := k.GetParams(ctx)
 
+	if msg.ResultHistorySize > params.MaxResultHistorySize {
+		ctx.Logger().Debug("RegisterInterchainQuery: result history size exceeds the limit", "message", msg)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidResultHistorySize, "result history size %d exceeds the limit %d",
+			msg.ResultHistorySize, params.MaxResultHistorySize)
+	}
+
+	if err := checkKVQueryKeysCount(params, msg.Keys); err != nil {
+		ctx.Logger().Debug("RegisterInterchainQuery: too many keys", "message", msg)
+		return nil, err
+	}
+
+	if err := checkTransactionsFiltersLength(params, msg.TransactionsFilter, msg.TxMessagesFilter); err != nil {
+		ctx.Logger().Debug("RegisterInterchainQuery: filter is too long", "message", msg)
+		return nil, err
+	}
+
+	if err := k.checkOwnerQueriesCount(ctx, params, msg.Sender); err != nil {
+		ctx.Logger().Debug("RegisterInterchainQuery: too many active queries", "message", msg)
+		return nil, err
+	}
+
 	registeredQuery := types.RegisteredQuery{
 		Id:                 lastID,
 		Owner:              msg.Sender,
@@ -62,8 +89,12 @@ func (k msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.Msg
 		QueryType:          msg.QueryType,
 		UpdatePeriod:       msg.UpdatePeriod,
 		ConnectionId:       msg.ConnectionId,
-		Deposit:            params.QueryDeposit,
+		Deposit:            params.QueryDepositFor(types.InterchainQueryType(msg.QueryType), uint64(len(msg.Keys))),
 		SubmitTimeout:      params.QuerySubmitTimeout,
+		RegisteredAtHeight: uint64(ctx.BlockHeight()),
+		SubmissionReward:   msg.SubmissionReward,
+		ResultHistorySize:  msg.ResultHistorySize,
+		TxMessagesFilter:   msg.TxMessagesFilter,
 	}
 
 	k.SetLastRegisteredQueryKey(ctx, lastID)
@@ -101,17 +132,12 @@ func (k msgServer) RemoveInterchainQuery(goCtx context.Context, msg *types.MsgRe
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization failed")
 	}
 
-	k.RemoveQueryByID(ctx, query.Id)
+	k.removeQuery(ctx, query)
 	k.MustPayOutDeposit(ctx, query.Deposit, msg.GetSigners()[0])
-	if types.InterchainQueryType(query.GetQueryType()).IsKV() {
-		k.removeQueryResultByID(ctx, query.Id)
-	}
+	k.mustRefundRewardEscrow(ctx, query)
 
 	ctx.EventManager().EmitEvents(getEventsQueryRemoved(query))
 
-	// NOTE: there is no easy way to remove the list of processed transactions
-	// without knowing transaction hashes.
-
 	return &types.MsgRemoveInterchainQueryResponse{}, nil
 }
 
@@ -132,11 +158,72 @@ func (k msgServer) UpdateInterchainQuery(goCtx context.Context, msg *types.MsgUp
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization failed")
 	}
 
+	params := k.GetParams(ctx)
+	queryType := types.InterchainQueryType(query.QueryType)
+
 	if msg.GetNewUpdatePeriod() > 0 {
 		query.UpdatePeriod = msg.GetNewUpdatePeriod()
 	}
 	if len(msg.GetNewKeys()) > 0 {
+		if !queryType.IsKV() {
+			ctx.Logger().Debug("UpdateInterchainQuery: keys can be set only for KV queries", "msg", msg)
+			return nil, sdkerrors.Wrap(types.ErrInvalidQueryType, "keys can be set only for KV queries")
+		}
+		if err := checkKVQueryKeysCount(params, msg.GetNewKeys()); err != nil {
+			ctx.Logger().Debug("UpdateInterchainQuery: too many keys", "msg", msg)
+			return nil, err
+		}
+		oldKeysCount := uint64(len(query.Keys))
 		query.Keys = msg.GetNewKeys()
+
+		// the deposit follows the amount of keys
+		if err := k.adjustDeposit(ctx, query, params.QueryDepositPerKey, oldKeysCount, uint64(len(query.Keys))); err != nil {
+			ctx.Logger().Debug("UpdateInterchainQuery: failed to adjust deposit", "msg", msg, "error", err)
+			return nil, sdkerrors.Wrapf(err, "failed to adjust deposit")
+		}
+	}
+	if msg.GetNewTransactionsFilter() != "" {
+		if !queryType.IsTX() {
+			ctx.Logger().Debug("UpdateInterchainQuery: transactions filter can be set only for TX queries", "msg", msg)
+			return nil, sdkerrors.Wrap(types.ErrInvalidQueryType, "transactions filter can be set only for TX queries")
+		}
+		if err := checkTransactionsFiltersLength(params, msg.GetNewTransactionsFilter(), ""); err != nil {
+			ctx.Logger().Debug("UpdateInterchainQuery: filter is too long", "msg", msg)
+			return nil, err
+		}
+		query.TransactionsFilter = msg.GetNewTransactionsFilter()
+	}
+	if msg.GetNewConnectionId() != "" && msg.GetNewConnectionId() != query.ConnectionId {
+		if _, err := k.ibcKeeper.ConnectionKeeper.Connection(goCtx, &ibcconnectiontypes.QueryConnectionRequest{ConnectionId: msg.GetNewConnectionId()}); err != nil {
+			ctx.Logger().Debug("UpdateInterchainQuery: failed to get connection with ID", "msg", msg)
+			return nil, sdkerrors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s': %v", msg.GetNewConnectionId(), err)
+		}
+		if err := k.checkConnectionClientActive(ctx, msg.GetNewConnectionId()); err != nil {
+			ctx.Logger().Debug("UpdateInterchainQuery: connection client is not active", "msg", msg, "error", err)
+			return nil, err
+		}
+		query.ConnectionId = msg.GetNewConnectionId()
+		// moving to a connection with an active client is the way to resume a suspended query. The query moved
+		// to a connection which is still suspended is resumed along with the other queries of the connection.
+		suspended := k.isConnectionSuspended(ctx, query.ConnectionId)
+		if query.Suspended && !suspended {
+			query.ResumedAtHeight = uint64(ctx.BlockHeight())
+		}
+		query.Suspended = suspended
+
+		// the heights, the results and the transactions of the previous counterparty have nothing to do with
+		// the new one. The processed transactions are removed in batches, there might be too many of them to
+		// remove at once.
+		query.LastSubmittedResultRemoteHeight = ibcclienttypes.ZeroHeight()
+		// the last verified solo machine sequences are kept, so the results signed for the query can't be
+		// replayed once it's moved back
+		if queryType.IsKV() {
+			k.removeQueryResultByID(ctx, query.Id)
+			k.removeQueryResultHistory(ctx, query.Id)
+		}
+		if queryType.IsTX() {
+			k.MarkTxQueryToRemove(ctx, query.Id)
+		}
 	}
 
 	err = k.SaveQuery(ctx, *query)
@@ -150,6 +237,60 @@ func (k msgServer) UpdateInterchainQuery(goCtx context.Context, msg *types.MsgUp
 	return &types.MsgUpdateInterchainQueryResponse{}, nil
 }
 
+// TransferInterchainQueryOwnership makes another account the owner of the query, TX queries can be owned by
+// contracts only. Everything but the owner is kept: the query id, the results, the processed transactions,
+// the deposit and the reward escrow, so the deposit and the escrow remainder are returned to the new owner
+// on the query removal.
+func (k msgServer) TransferInterchainQueryOwnership(goCtx context.Context, msg *types.MsgTransferInterchainQueryOwnership) (*types.MsgTransferInterchainQueryOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("TransferInterchainQueryOwnership", "msg", msg)
+
+	query, err := k.GetQueryByID(ctx, msg.GetQueryId())
+	if err != nil {
+		ctx.Logger().Debug("TransferInterchainQueryOwnership: failed to GetQueryByID",
+			"error", err, "query_id", msg.QueryId)
+		return nil, sdkerrors.Wrapf(err, "failed to get query by query id: %v", err)
+	}
+
+	if query.GetOwner() != msg.GetSender() {
+		ctx.Logger().Debug("TransferInterchainQueryOwnership: authorization failed",
+			"msg", msg)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization failed")
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.GetNewOwner())
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse new owner address: %s", msg.GetNewOwner())
+	}
+
+	if types.InterchainQueryType(query.QueryType).IsTX() && !k.wasmKeeper.HasContractInfo(ctx, newOwner) {
+		ctx.Logger().Debug("TransferInterchainQueryOwnership: new owner is not a contract", "new_owner", msg.NewOwner)
+		return nil, sdkerrors.Wrapf(types.ErrNotContract, "only contracts can own TX queries, %s is not a contract address", msg.NewOwner)
+	}
+
+	// the ownership transfer to the current owner would be counted against the owner quota twice
+	if newOwner.String() == query.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already the owner of query %d", newOwner, query.Id)
+	}
+
+	if err := k.checkOwnerQueriesCount(ctx, k.GetParams(ctx), newOwner.String()); err != nil {
+		ctx.Logger().Debug("TransferInterchainQueryOwnership: too many active queries", "new_owner", msg.NewOwner)
+		return nil, err
+	}
+
+	previousOwner := query.Owner
+	query.Owner = newOwner.String()
+
+	if err := k.SaveQuery(ctx, *query); err != nil {
+		ctx.Logger().Debug("TransferInterchainQueryOwnership: failed to save query", "message", &msg, "error", err)
+		return nil, sdkerrors.Wrapf(err, "failed to save query by query id: %v", err)
+	}
+
+	ctx.EventManager().EmitEvents(getEventsQueryOwnershipTransferred(query, previousOwner))
+
+	return &types.MsgTransferInterchainQueryOwnershipResponse{}, nil
+}
+
 func (k msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmitQueryResult) (*types.MsgSubmitQueryResultResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelRegisterInterchainQuery)
 
@@ -163,6 +304,10 @@ func (k msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 		return nil, sdkerrors.Wrapf(err, "failed to get query by id: %v", err)
 	}
 
+	if query.Suspended {
+		return nil, sdkerrors.Wrapf(types.ErrQuerySuspended, "results can't be submitted for suspended query %d", query.Id)
+	}
+
 	if len(msg.Result.KvResults) != len(query.Keys) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV keys length from result is not equal to registered query keys length: %v != %v", len(msg.Result.KvResults), query.Keys)
 	}
@@ -179,67 +324,45 @@ func (k msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, sdkerrors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
 		}
 
-		resp, err := k.ibcKeeper.ConnectionConsensusState(goCtx, &ibcconnectiontypes.QueryConnectionConsensusStateRequest{
-			ConnectionId:   query.ConnectionId,
-			RevisionNumber: msg.Result.Revision,
-			RevisionHeight: msg.Result.Height + 1,
-		})
-		if err != nil {
-			ctx.Logger().Debug("SubmitQueryResult: failed to get ConnectionConsensusState",
-				"error", err, "query", query, "message", msg)
-			return nil, sdkerrors.Wrapf(ibcclienttypes.ErrConsensusStateNotFound, "failed to get consensus state: %v", err)
+		if !query.IsDueForUpdate(uint64(ctx.BlockHeight())) {
+			return nil, sdkerrors.Wrapf(types.ErrQueryNotDueForUpdate, "query result can't be submitted earlier than at height %d",
+				query.LastSubmittedResultLocalHeight+query.UpdatePeriod)
 		}
 
-		consensusState, err := ibcclienttypes.UnpackConsensusState(resp.ConsensusState)
-		if err != nil {
-			ctx.Logger().Error("SubmitQueryResult: failed to UnpackConsensusState",
-				"error", err, "query", query, "message", msg)
-			return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack consesus state: %v", err)
+		connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+		if !ok || connection.ClientId != msg.ClientId {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidClientID, "query connection %s doesn't belong to client %s", query.ConnectionId, msg.ClientId)
 		}
 
-		clientState, err := k.GetClientState(ctx, msg.ClientId)
+		if msg.Result.Header != nil {
+			if err := k.updateClientWithHeader(ctx, msg.ClientId, msg.Result.Header, msg.Result.Revision, msg.Result.Height); err != nil {
+				ctx.Logger().Debug("SubmitQueryResult: failed to update client with header",
+					"error", err, "query", query, "message", msg)
+				return nil, err
+			}
+		}
+
+		clientState, verifier, err := k.getProofVerifier(ctx, msg.ClientId)
 		if err != nil {
 			return nil, err
 		}
 
-		for index, result := range msg.Result.KvResults {
-			proof, err := ibccommitmenttypes.ConvertProofs(result.Proof)
-			if err != nil {
-				ctx.Logger().Debug("SubmitQueryResult: failed to ConvertProofs",
-					"error", err, "query", query, "message", msg)
-				return nil, sdkerrors.Wrapf(types.ErrInvalidType, "failed to convert crypto.ProofOps to MerkleProof: %v", err)
-			}
-
-			if !bytes.Equal(result.Key, query.Keys[index].Key) {
-				return nil, sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV key from result is not equal to registered query key: %v != %v", result.Key, query.Keys[index].Key)
-			}
+		height := ibcclienttypes.NewHeight(msg.Result.Revision, msg.Result.Height)
+		consensusState, err := verifier.VerifyHeight(ctx, msg.ClientId, clientState, height)
+		if err != nil {
+			ctx.Logger().Debug("SubmitQueryResult: failed to verify results height",
+				"error", err, "query", query, "message", msg)
+			return nil, err
+		}
 
-			if result.StoragePrefix != query.Keys[index].Path {
-				return nil, sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV path from result is not equal to registered query storage prefix: %v != %v", result.StoragePrefix, query.Keys[index].Path)
-			}
+		if err := k.verifyKVResults(ctx, query, msg.Result.KvResults, msg.ClientId, clientState, consensusState, verifier, height); err != nil {
+			ctx.Logger().Debug("SubmitQueryResult: failed to verify KV results",
+				"error", err, "query", query, "message", msg)
+			return nil, err
+		}
 
-			path := ibccommitmenttypes.NewMerklePath(result.StoragePrefix, string(result.Key))
-
-			// identify what kind proofs (non-existence proof always has *ics23.CommitmentProof_Nonexist as the first item) we got
-			// and call corresponding method to verify it
-			switch proof.GetProofs()[0].GetProof().(type) {
-			// we can get non-existence proof if someone queried some key which is not exists in the storage on remote chain
-			case *ics23.CommitmentProof_Nonexist:
-				if err := proof.VerifyNonMembership(clientState.ProofSpecs, consensusState.GetRoot(), path); err != nil {
-					ctx.Logger().Debug("SubmitQueryResult: failed to VerifyNonMembership",
-						"error", err, "query", query, "message", msg, "path", path)
-					return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "failed to verify proof: %v", err)
-				}
-				result.Value = nil
-			case *ics23.CommitmentProof_Exist:
-				if err := proof.VerifyMembership(clientState.ProofSpecs, consensusState.GetRoot(), path, result.Value); err != nil {
-					ctx.Logger().Debug("SubmitQueryResult: failed to VerifyMembership",
-						"error", err, "query", query, "message", msg, "path", path)
-					return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "failed to verify proof: %v", err)
-				}
-			default:
-				return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "unknown proof type %T", proof.GetProofs()[0].GetProof())
-			}
+		if err := verifier.OnResultsVerified(ctx, msg.ClientId, clientState, height, query.Id); err != nil {
+			return nil, err
 		}
 
 		if err = k.SaveKVQueryResult(ctx, msg.QueryId, msg.Result); err != nil {
@@ -248,29 +371,46 @@ func (k msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, sdkerrors.Wrapf(err, "failed to SaveKVQueryResult: %v", err)
 		}
 
+		if err := k.PayRelayerReward(ctx, query.Id, msg.GetSigners()[0]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to pay relayer reward: %v", err)
+		}
+
 		if msg.Result.GetAllowKvCallbacks() {
-			// Let the query owner contract process the query result.
-			if _, err := k.sudoHandler.SudoKVQueryResult(ctx, queryOwner, query.Id); err != nil {
-				ctx.Logger().Debug("SubmitQueryResult: failed to SudoKVQueryResult",
-					"error", err, "query_id", query.GetId())
-				return nil, sdkerrors.Wrapf(err, "contract %s rejected KV query result (query_id: %d)",
-					queryOwner, query.GetId())
+			// Let the query owner contract process the query result. The result is saved even if the contract
+			// fails to process it, the failure is recorded to be looked into later.
+			if err := k.sudoKVQueryResult(ctx, queryOwner, query.Id, ibcclienttypes.NewHeight(msg.Result.Revision, msg.Result.Height)); err != nil {
+				return nil, err
 			}
 			return &types.MsgSubmitQueryResultResponse{}, nil
 		}
 	}
 
-	if msg.Result.Block != nil && msg.Result.Block.Tx != nil {
+	if msg.Result.Block != nil && len(msg.Result.Block.Transactions()) > 0 {
 		if !types.InterchainQueryType(query.QueryType).IsTX() {
 			return nil, sdkerrors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
 		}
 
-		if err := k.ProcessBlock(ctx, queryOwner, msg.QueryId, msg.ClientId, msg.Result.Block); err != nil {
+		// the transactions processed on the previous connection of the query are still being removed, the new
+		// ones can't be told apart from them until then
+		if k.IsTxQueryToRemove(ctx, query.Id) {
+			return nil, sdkerrors.Wrapf(types.ErrTxQueryToRemove, "results can't be submitted for query %d until its processed transactions are removed", query.Id)
+		}
+
+		processed, err := k.ProcessBlock(ctx, queryOwner, query, msg.ClientId, msg.Result.Block)
+		if err != nil {
 			ctx.Logger().Debug("SubmitQueryResult: failed to ProcessBlock",
 				"error", err, "query", query, "message", msg)
 			return nil, sdkerrors.Wrapf(err, "failed to ProcessBlock: %v", err)
 		}
 
+		// only new transactions are rewarded, so the same transaction can't be submitted again and
+		// again to drain the reward escrow
+		for i := uint64(0); i < processed; i++ {
+			if err := k.PayRelayerReward(ctx, query.Id, msg.GetSigners()[0]); err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to pay relayer reward: %v", err)
+			}
+		}
+
 		if err = k.UpdateLastLocalHeight(ctx, query.Id, uint64(ctx.BlockHeight())); err != nil {
 			return nil, sdkerrors.Wrapf(err,
 				"failed to update last local height for a result with id %d: %v", query.Id, err)
@@ -280,6 +420,226 @@ func (k msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 	return &types.MsgSubmitQueryResultResponse{}, nil
 }
 
+func (k msgServer) SubmitQueryResults(goCtx context.Context, msg *types.MsgSubmitQueryResults) (*types.MsgSubmitQueryResultsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelSubmitQueryResults)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("SubmitQueryResults", "results", len(msg.Results))
+
+	if msg.Header != nil {
+		if err := k.updateClientWithHeader(ctx, msg.ClientId, msg.Header, msg.Revision, msg.Height); err != nil {
+			ctx.Logger().Debug("SubmitQueryResults: failed to update client with header", "error", err)
+			return nil, err
+		}
+	}
+
+	clientState, verifier, err := k.getProofVerifier(ctx, msg.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusState, err := verifier.VerifyHeight(ctx, msg.ClientId, clientState, ibcclienttypes.NewHeight(msg.Revision, msg.Height))
+	if err != nil {
+		ctx.Logger().Debug("SubmitQueryResults: failed to verify results height", "error", err)
+		return nil, err
+	}
+
+	relayer := msg.GetSigners()[0]
+	resp := &types.MsgSubmitQueryResultsResponse{Results: make([]types.QueryResultSubmission, 0, len(msg.Results))}
+	for i := range msg.Results {
+		result := &msg.Results[i]
+		status := types.QueryResultSubmission{QueryId: result.QueryId, Success: true}
+
+		// every result is processed in a separate cached context, so a rejected result doesn't affect
+		// the rest of the batch
+		cacheCtx, writeFn := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.submitKVQueryResult(cacheCtx, msg.ClientId, msg.Revision, msg.Height, result, clientState, consensusState, verifier, relayer); err != nil {
+			ctx.Logger().Debug("SubmitQueryResults: failed to submit KV query result",
+				"error", err, "query_id", result.QueryId)
+			status.Success = false
+			status.Error = err.Error()
+		} else {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		resp.Results = append(resp.Results, status)
+	}
+
+	return resp, nil
+}
+
+// submitKVQueryResult verifies the KV result of a query submitted within MsgSubmitQueryResults with
+// the preloaded client state, consensus state and proof verifier, saves it, pays the relayer reward and calls the query owner
+// contract if the callbacks are allowed.
+func (k msgServer) submitKVQueryResult(
+	ctx sdk.Context,
+	clientID string,
+	revision uint64,
+	height uint64,
+	result *types.KVQueryResult,
+	clientState exported.ClientState,
+	consensusState exported.ConsensusState,
+	verifier ProofVerifier,
+	relayer sdk.AccAddress,
+) error {
+	query, err := k.GetQueryByID(ctx, result.QueryId)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get query by id: %v", err)
+	}
+
+	if query.Suspended {
+		return sdkerrors.Wrapf(types.ErrQuerySuspended, "results can't be submitted for suspended query %d", query.Id)
+	}
+
+	if !types.InterchainQueryType(query.QueryType).IsKV() {
+		return sdkerrors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
+	}
+
+	connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, query.ConnectionId)
+	if !ok || connection.ClientId != clientID {
+		return sdkerrors.Wrapf(types.ErrInvalidClientID, "query connection %s doesn't belong to client %s", query.ConnectionId, clientID)
+	}
+
+	if !query.IsDueForUpdate(uint64(ctx.BlockHeight())) {
+		return sdkerrors.Wrapf(types.ErrQueryNotDueForUpdate, "query result can't be submitted earlier than at height %d",
+			query.LastSubmittedResultLocalHeight+query.UpdatePeriod)
+	}
+
+	if err := k.verifyKVResults(ctx, query, result.KvResults, clientID, clientState, consensusState, verifier, ibcclienttypes.NewHeight(revision, height)); err != nil {
+		return err
+	}
+
+	if err := verifier.OnResultsVerified(ctx, clientID, clientState, ibcclienttypes.NewHeight(revision, height), query.Id); err != nil {
+		return err
+	}
+
+	queryResult := &types.QueryResult{
+		KvResults:        result.KvResults,
+		Height:           height,
+		Revision:         revision,
+		AllowKvCallbacks: result.AllowKvCallbacks,
+	}
+	if err := k.SaveKVQueryResult(ctx, query.Id, queryResult); err != nil {
+		return sdkerrors.Wrapf(err, "failed to SaveKVQueryResult: %v", err)
+	}
+
+	if err := k.PayRelayerReward(ctx, query.Id, relayer); err != nil {
+		return sdkerrors.Wrapf(err, "failed to pay relayer reward: %v", err)
+	}
+
+	if result.AllowKvCallbacks {
+		queryOwner, err := query.GetOwnerAddress()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to decode owner contract address (%s)", query.Owner)
+		}
+
+		// Let the query owner contract process the query result.
+		if err := k.sudoKVQueryResult(ctx, queryOwner, query.Id, ibcclienttypes.NewHeight(revision, height)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyKVResults checks that the KV results match the registered query keys and verifies their proofs
+// with the proof verifier of the client against the consensus state returned by its VerifyHeight.
+func (k Keeper) verifyKVResults(
+	ctx sdk.Context,
+	query *types.RegisteredQuery,
+	kvResults []*types.StorageValue,
+	clientID string,
+	clientState exported.ClientState,
+	consensusState exported.ConsensusState,
+	verifier ProofVerifier,
+	height exported.Height,
+) error {
+	if len(kvResults) != len(query.Keys) {
+		return sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV keys length from result is not equal to registered query keys length: %v != %v", len(kvResults), query.Keys)
+	}
+
+	for index, result := range kvResults {
+		if !bytes.Equal(result.Key, query.Keys[index].Key) {
+			return sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV key from result is not equal to registered query key: %v != %v", result.Key, query.Keys[index].Key)
+		}
+
+		if result.StoragePrefix != query.Keys[index].Path {
+			return sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "KV path from result is not equal to registered query storage prefix: %v != %v", result.StoragePrefix, query.Keys[index].Path)
+		}
+
+		if err := verifier.VerifyKVResult(ctx, clientID, clientState, consensusState, height, result); err != nil {
+			ctx.Logger().Debug("verifyKVResults: failed to verify KV result",
+				"error", err, "query_id", query.Id, "key", result.Key)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k msgServer) FundQueryReward(goCtx context.Context, msg *types.MsgFundQueryReward) (*types.MsgFundQueryRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("FundQueryReward", "msg", msg)
+
+	if err := k.Keeper.FundQueryReward(ctx, msg.GetQueryId(), msg.GetSigners()[0], msg.GetAmount()); err != nil {
+		ctx.Logger().Debug("FundQueryReward: failed to fund query reward",
+			"error", err, "query_id", msg.QueryId)
+		return nil, err
+	}
+
+	return &types.MsgFundQueryRewardResponse{}, nil
+}
+
+func (k msgServer) RetrySudoFailure(goCtx context.Context, msg *types.MsgRetrySudoFailure) (*types.MsgRetrySudoFailureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("RetrySudoFailure", "msg", msg)
+
+	if err := k.Keeper.RetrySudoFailure(ctx, msg.GetQueryId(), msg.GetFailureId(), msg.GetSigners()[0]); err != nil {
+		ctx.Logger().Debug("RetrySudoFailure: failed to retry sudo failure",
+			"error", err, "query_id", msg.QueryId, "failure_id", msg.FailureId)
+		return nil, err
+	}
+
+	return &types.MsgRetrySudoFailureResponse{}, nil
+}
+
+func checkKVQueryKeysCount(params types.Params, keys []*types.KVKey) error {
+	if params.MaxKvQueryKeysCount > 0 && uint64(len(keys)) > params.MaxKvQueryKeysCount {
+		return sdkerrors.Wrapf(types.ErrTooManyKVQueryKeys, "keys count %d exceeds the limit %d",
+			len(keys), params.MaxKvQueryKeysCount)
+	}
+	return nil
+}
+
+func checkTransactionsFiltersLength(params types.Params, transactionsFilter, txMessagesFilter string) error {
+	if params.MaxTransactionsFilterLength == 0 {
+		return nil
+	}
+
+	if uint64(len(transactionsFilter)) > params.MaxTransactionsFilterLength {
+		return sdkerrors.Wrapf(types.ErrInvalidTransactionsFilter, "transactions filter length %d exceeds the limit %d",
+			len(transactionsFilter), params.MaxTransactionsFilterLength)
+	}
+
+	if uint64(len(txMessagesFilter)) > params.MaxTransactionsFilterLength {
+		return sdkerrors.Wrapf(types.ErrInvalidTxMessagesFilter, "transaction messages filter length %d exceeds the limit %d",
+			len(txMessagesFilter), params.MaxTransactionsFilterLength)
+	}
+
+	return nil
+}
+
+// checkOwnerQueriesCount checks whether the owner can have one more registered query.
+func (k Keeper) checkOwnerQueriesCount(ctx sdk.Context, params types.Params, owner string) error {
+	if count := k.GetOwnerQueriesCount(ctx, owner); params.MaxActiveQueriesPerOwner > 0 && count >= params.MaxActiveQueriesPerOwner {
+		return sdkerrors.Wrapf(types.ErrTooManyActiveQueries, "%s already has %d active queries, the limit is %d",
+			owner, count, params.MaxActiveQueriesPerOwner)
+	}
+	return nil
+}
+
 func getEventsQueryUpdated(query *types.RegisteredQuery) sdk.Events {
 	return sdk.Events{
 		sdk.NewEvent(
@@ -296,6 +656,19 @@ func getEventsQueryUpdated(query *types.RegisteredQuery) sdk.Events {
 	}
 }
 
+func getEventsQueryOwnershipTransferred(query *types.RegisteredQuery, previousOwner string) sdk.Events {
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNeutronMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryOwnershipTransferred),
+			sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner),
+		),
+	}
+}
+
 func getEventsQueryRemoved(query *types.RegisteredQuery) sdk.Events {
 	return sdk.Events{
 		sdk.NewEvent(
@@ -85,66 +85,103 @@ func (k Keeper) VerifyHeaders(ctx sdk.Context, clientID string, header exported.
 	return nil
 }
 
-// ProcessBlock verifies headers and transaction in the block, and then passes the tx query result to
-// the querying contract's sudo handler.
-func (k Keeper) ProcessBlock(ctx sdk.Context, queryOwner sdk.AccAddress, queryID uint64, clientID string, block *types.Block) error {
+// ProcessBlock verifies headers and transactions in the block, and then passes every new tx query result to
+// the querying contract's sudo handler. All the block transactions are verified against the same pair of headers,
+// already processed transactions are skipped. If the query has the transaction messages filter set, the
+// transactions not matching it are rejected. Returns the amount of new transactions processed.
+func (k Keeper) ProcessBlock(ctx sdk.Context, queryOwner sdk.AccAddress, query *types.RegisteredQuery, clientID string, block *types.Block) (uint64, error) {
+	queryID := query.Id
+
+	var txMessagesFilter types.TxMessagesFilter
+	if query.TxMessagesFilter != "" {
+		filter, err := types.ParseTxMessagesFilter(query.TxMessagesFilter)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to parse transaction messages filter of query %d", queryID)
+		}
+		txMessagesFilter = filter
+	}
+
 	header, err := ibcclienttypes.UnpackHeader(block.Header)
 	if err != nil {
 		ctx.Logger().Debug("ProcessBlock: failed to unpack block header", "error", err)
-		return sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack block header: %v", err)
+		return 0, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack block header: %v", err)
 	}
 
 	nextHeader, err := ibcclienttypes.UnpackHeader(block.NextBlockHeader)
 	if err != nil {
 		ctx.Logger().Debug("ProcessBlock: failed to unpack block header", "error", err)
-		return sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack block header: %v", err)
+		return 0, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unpack block header: %v", err)
 	}
 
 	if err := k.VerifyHeaders(ctx, clientID, header, nextHeader); err != nil {
 		ctx.Logger().Debug("ProcessBlock: failed to verify headers", "error", err)
-		return sdkerrors.Wrapf(types.ErrInvalidHeader, "failed to verify headers: %v", err)
+		return 0, sdkerrors.Wrapf(types.ErrInvalidHeader, "failed to verify headers: %v", err)
 	}
 
 	tmHeader, ok := header.(*tendermintLightClientTypes.Header)
 	if !ok {
 		ctx.Logger().Debug("ProcessBlock: failed to cast current header to tendermint Header", "query_id", queryID)
-		return sdkerrors.Wrap(types.ErrInvalidType, "failed to cast current header to tendermint Header")
+		return 0, sdkerrors.Wrap(types.ErrInvalidType, "failed to cast current header to tendermint Header")
 	}
 
 	tmNextHeader, ok := nextHeader.(*tendermintLightClientTypes.Header)
 	if !ok {
 		ctx.Logger().Debug("ProcessBlock: failed to cast next header to tendermint Header", "query_id", queryID)
-		return sdkerrors.Wrap(types.ErrInvalidType, "failed to cast next header to tendermint header")
+		return 0, sdkerrors.Wrap(types.ErrInvalidType, "failed to cast next header to tendermint header")
 	}
 
+	// all the new transactions are verified before any of them is passed to the contract, transactions
+	// processed by previous submissions or duplicated in the block are skipped
 	var (
-		tx     = block.GetTx()
-		txData = tx.GetData()
-		txHash = tmtypes.Tx(txData).Hash()
+		newTxs   = make([]*types.TxValue, 0, len(block.Transactions()))
+		txHashes = make(map[string]struct{})
 	)
-	if !k.CheckTransactionIsAlreadyProcessed(ctx, queryID, txHash) {
+	for _, tx := range block.Transactions() {
+		txHash := tmtypes.Tx(tx.GetData()).Hash()
+		if _, ok := txHashes[string(txHash)]; ok || k.CheckTransactionIsAlreadyProcessed(ctx, queryID, txHash) {
+			ctx.Logger().Debug("ProcessBlock: transaction was already submitted",
+				"query_id", queryID, "tx_hash", hex.EncodeToString(txHash))
+			continue
+		}
+		txHashes[string(txHash)] = struct{}{}
+
 		// Check that cryptography is O.K. (tx is included in the block, tx was executed successfully)
 		if err = k.verifyTransaction(tmHeader, tmNextHeader, tx); err != nil {
 			ctx.Logger().Debug("ProcessBlock: failed to verifyTransaction",
 				"error", err, "query_id", queryID, "tx_hash", hex.EncodeToString(txHash))
-			return sdkerrors.Wrapf(types.ErrInternal, "failed to verifyTransaction %s: %v", hex.EncodeToString(txHash), err)
+			return 0, sdkerrors.Wrapf(types.ErrInternal, "failed to verifyTransaction %s: %v", hex.EncodeToString(txHash), err)
 		}
 
-		// Let the query owner contract process the query result.
-		if _, err := k.sudoHandler.SudoTxQueryResult(ctx, queryOwner, queryID, tmHeader.Header.Height, txData); err != nil {
-			ctx.Logger().Debug("ProcessBlock: failed to SudoTxQueryResult",
-				"error", err, "query_id", queryID, "tx_hash", hex.EncodeToString(txHash))
-			return sdkerrors.Wrapf(err, "contract %s rejected transaction query result (tx_hash: %s)",
-				queryOwner, hex.EncodeToString(txHash))
+		if txMessagesFilter != nil {
+			matched, err := txMessagesFilter.MatchTx(k.cdc, tx.GetData())
+			if err != nil {
+				ctx.Logger().Debug("ProcessBlock: failed to match transaction against filter",
+					"error", err, "query_id", queryID, "tx_hash", hex.EncodeToString(txHash))
+				return 0, sdkerrors.Wrapf(types.ErrTxDoesNotMatchFilter, "failed to match transaction %s against filter: %v", hex.EncodeToString(txHash), err)
+			}
+			if !matched {
+				return 0, sdkerrors.Wrapf(types.ErrTxDoesNotMatchFilter, "transaction %s doesn't match filter of query %d", hex.EncodeToString(txHash), queryID)
+			}
+		}
+
+		newTxs = append(newTxs, tx)
+	}
+
+	for _, tx := range newTxs {
+		var (
+			txData = tx.GetData()
+			txHash = tmtypes.Tx(txData).Hash()
+		)
+		// Let the query owner contract process the query result. The transaction is processed even if
+		// the contract fails to process it, the failure is recorded to be looked into later.
+		if err := k.sudoTxQueryResult(ctx, queryOwner, queryID, tmHeader.Header.Height, txData); err != nil {
+			return 0, err
 		}
 
 		k.SaveTransactionAsProcessed(ctx, queryID, txHash)
-	} else {
-		ctx.Logger().Debug("ProcessBlock: transaction was already submitted",
-			"query_id", queryID, "tx_hash", hex.EncodeToString(txHash))
 	}
 
-	return nil
+	return uint64(len(newTxs)), nil
 }
 
 // verifyTransaction verifies that some transaction is included in block, and the transaction was executed successfully.
@@ -5,9 +5,12 @@ import (
 	"math"
 	"time"
 
-	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
@@ -21,12 +24,20 @@ import (
 	tmversion "github.com/tendermint/tendermint/version"
 
 	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
 )
 
 // CreateTMClientHeader creates a TM header to update the TM client. Args are passed in to allow
 // caller flexibility to use params that differ from the chain.
 func CreateTMClientHeader(chain *ibctesting.TestChain, chainID string, blockHeight int64, trustedHeight ibcclienttypes.Height, timestamp time.Time, tmValSet, tmTrustedVals *tmtypes.ValidatorSet, signers []tmtypes.PrivValidator, previousHeader *tmtypes.Header) *ibctmtypes.Header {
+	return createTMClientHeaderWithHashes(chain, chainID, blockHeight, trustedHeight, timestamp, tmValSet, tmTrustedVals, signers, previousHeader,
+		tmhash.Sum([]byte("data_hash")), tmhash.Sum([]byte("last_results_hash")))
+}
+
+// createTMClientHeaderWithHashes creates a TM header with the given DataHash and LastResultsHash, so the header
+// can be used to verify transactions included in the block and their results.
+func createTMClientHeaderWithHashes(chain *ibctesting.TestChain, chainID string, blockHeight int64, trustedHeight ibcclienttypes.Height, timestamp time.Time, tmValSet, tmTrustedVals *tmtypes.ValidatorSet, signers []tmtypes.PrivValidator, previousHeader *tmtypes.Header, dataHash, lastResultsHash []byte) *ibctmtypes.Header {
 	var (
 		valSet      *tmproto.ValidatorSet
 		trustedVals *tmproto.ValidatorSet
@@ -42,12 +53,12 @@ func CreateTMClientHeader(chain *ibctesting.TestChain, chainID string, blockHeig
 		Time:               timestamp,
 		LastBlockID:        ibctesting.MakeBlockID(previousHeader.Hash(), 10_000, make([]byte, tmhash.Size)),
 		LastCommitHash:     chain.App.LastCommitID().Hash,
-		DataHash:           tmhash.Sum([]byte("data_hash")),
+		DataHash:           dataHash,
 		ValidatorsHash:     vsetHash,
 		NextValidatorsHash: vsetHash,
 		ConsensusHash:      tmhash.Sum([]byte("consensus_hash")),
 		AppHash:            chain.CurrentHeader.AppHash,
-		LastResultsHash:    tmhash.Sum([]byte("last_results_hash")),
+		LastResultsHash:    lastResultsHash,
 		EvidenceHash:       tmhash.Sum([]byte("evidence_hash")),
 		ProposerAddress:    tmValSet.Proposer.Address, //nolint:staticcheck
 	}
@@ -283,7 +294,7 @@ func (suite *KeeperTestSuite) TestUnpackAndVerifyHeaders() {
 
 			var (
 				ctx           = suite.ChainA.GetContext()
-				contractOwner = keeper.RandomAccountAddress(suite.T()) // We don't care what this address is
+				contractOwner = wasmKeeper.RandomAccountAddress(suite.T()) // We don't care what this address is
 			)
 
 			// Store code and instantiate reflect contract.
@@ -303,3 +314,220 @@ func (suite *KeeperTestSuite) TestUnpackAndVerifyHeaders() {
 		})
 	}
 }
+
+// makeBlockWithTxs builds a block of the chain B at the height containing the txs. The block headers are
+// signed by the chain B validators, so they can be used to update the chain B client on the chain A.
+func (suite *KeeperTestSuite) makeBlockWithTxs(height int64, timestamp time.Time, txs tmtypes.Txs) *iqtypes.Block {
+	var (
+		chain     = suite.ChainB
+		ibcKeeper = suite.GetNeutronZoneApp(suite.ChainA).IBCKeeper
+	)
+
+	clientState, ok := ibcKeeper.ClientKeeper.GetClientState(suite.ChainA.GetContext(), suite.Path.EndpointA.ClientID)
+	suite.Require().True(ok)
+	trustedHeight := clientState.GetLatestHeight().(ibcclienttypes.Height)
+
+	responses := make([]*abci.ResponseDeliverTx, 0, len(txs))
+	for i := range txs {
+		responses = append(responses, &abci.ResponseDeliverTx{Code: abci.CodeTypeOK, Data: []byte(fmt.Sprintf("response %d", i))})
+	}
+	results := tmtypes.NewResults(responses)
+
+	previousHeader, err := tmtypes.HeaderFromProto(chain.LastHeader.Header)
+	suite.Require().NoError(err)
+	header := createTMClientHeaderWithHashes(chain, chain.ChainID, height, trustedHeight, timestamp, chain.Vals, chain.Vals, chain.Signers,
+		&previousHeader, txs.Hash(), tmhash.Sum([]byte("last_results_hash")))
+
+	tmHeader, err := tmtypes.HeaderFromProto(header.Header)
+	suite.Require().NoError(err)
+	nextHeader := createTMClientHeaderWithHashes(chain, chain.ChainID, height+1, trustedHeight, timestamp.Add(time.Second), chain.Vals, chain.Vals, chain.Signers,
+		&tmHeader, tmhash.Sum([]byte("data_hash")), results.Hash())
+
+	packedHeader, err := ibcclienttypes.PackHeader(header)
+	suite.Require().NoError(err)
+	packedNextHeader, err := ibcclienttypes.PackHeader(nextHeader)
+	suite.Require().NoError(err)
+
+	block := &iqtypes.Block{Header: packedHeader, NextBlockHeader: packedNextHeader}
+	for i, tx := range txs {
+		deliveryProof := results.ProveResult(i)
+		inclusionProof := txs.Proof(i).Proof
+		block.Txs = append(block.Txs, &iqtypes.TxValue{
+			Response:       responses[i],
+			DeliveryProof:  deliveryProof.ToProto(),
+			InclusionProof: inclusionProof.ToProto(),
+			Data:           tx,
+		})
+	}
+
+	return block
+}
+
+func (suite *KeeperTestSuite) TestSubmitBlockWithManyTxs() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = neutronApp.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		reward        = types.NewCoins(types.NewCoin(types.DefaultBondDenom, types.NewInt(100)))
+	)
+
+	// Store code and instantiate reflect contract.
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	res, err := msgSrv.RegisterInterchainQuery(types.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             contractAddress.String(),
+		SubmissionReward:   reward,
+	})
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.FundQueryReward(types.WrapSDKContext(ctx), &iqtypes.MsgFundQueryReward{
+		QueryId: res.Id,
+		Amount:  types.NewCoins(types.NewCoin(types.DefaultBondDenom, types.NewInt(1_000))),
+		Sender:  senderAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	submit := func(block *iqtypes.Block) error {
+		_, err := msgSrv.SubmitQueryResult(types.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   relayer.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result:   &iqtypes.QueryResult{Block: block},
+		})
+		return err
+	}
+	relayerBalance := func() types.Int {
+		return neutronApp.BankKeeper.GetBalance(ctx, relayer, types.DefaultBondDenom).Amount
+	}
+	txs := tmtypes.Txs{tmtypes.Tx("first tx"), tmtypes.Tx("second tx"), tmtypes.Tx("third tx")}
+	baseHeight := suite.ChainB.CurrentHeader.Height + 10
+	baseTime := suite.Coordinator.CurrentTime
+	// the headers must not be from the future for the chain A
+	ctx = ctx.WithBlockTime(baseTime)
+
+	// a block with a transaction with invalid proof is rejected as a whole
+	block := suite.makeBlockWithTxs(baseHeight, baseTime.Add(time.Second), txs[:2])
+	block.Txs[1].Response.Code = 1
+	suite.Require().ErrorIs(submit(block), iqtypes.ErrInternal)
+	for _, tx := range txs[:2] {
+		suite.Require().False(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, tx.Hash()))
+	}
+
+	// all the transactions of the block are processed, the duplicated one only once
+	block = suite.makeBlockWithTxs(baseHeight+2, baseTime.Add(3*time.Second), txs[:2])
+	block.Tx, block.Txs = block.Txs[0], append(block.Txs, block.Txs[0])
+	suite.Require().NoError(submit(block))
+	for _, tx := range txs[:2] {
+		suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, tx.Hash()))
+	}
+	suite.Require().Equal(types.NewInt(200), relayerBalance())
+
+	// already processed transactions are skipped, only the new one is processed and rewarded
+	block = suite.makeBlockWithTxs(baseHeight+4, baseTime.Add(5*time.Second), txs)
+	suite.Require().NoError(submit(block))
+	suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, txs[2].Hash()))
+	suite.Require().Equal(types.NewInt(300), relayerBalance())
+}
+
+func (suite *KeeperTestSuite) TestSubmitBlockWithTxMessagesFilter() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = neutronApp.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		recipient     = wasmKeeper.RandomAccountAddress(suite.T())
+		coins         = types.NewCoins(types.NewCoin("uatom", types.NewInt(100)))
+	)
+
+	// Store code and instantiate reflect contract.
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	res, err := msgSrv.RegisterInterchainQuery(types.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: fmt.Sprintf(`[{"field":"transfer.recipient","op":"eq","value":"%s"}]`, recipient),
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             contractAddress.String(),
+		TxMessagesFilter:   fmt.Sprintf(`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"%s"}]}]`, recipient),
+	})
+	suite.Require().NoError(err)
+
+	submit := func(block *iqtypes.Block) error {
+		_, err := msgSrv.SubmitQueryResult(types.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   contractOwner.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result:   &iqtypes.QueryResult{Block: block},
+		})
+		return err
+	}
+
+	var (
+		matchingTx = suite.encodeTx(&banktypes.MsgSend{FromAddress: senderAddress.String(), ToAddress: recipient.String(), Amount: coins})
+		otherTx    = suite.encodeTx(&banktypes.MsgSend{FromAddress: recipient.String(), ToAddress: senderAddress.String(), Amount: coins})
+		baseHeight = suite.ChainB.CurrentHeader.Height + 10
+		baseTime   = suite.Coordinator.CurrentTime
+	)
+	// the headers must not be from the future for the chain A
+	ctx = ctx.WithBlockTime(baseTime)
+
+	// a block with a transaction not matching the filter is rejected as a whole
+	block := suite.makeBlockWithTxs(baseHeight, baseTime.Add(time.Second), tmtypes.Txs{matchingTx, otherTx})
+	suite.Require().ErrorIs(submit(block), iqtypes.ErrTxDoesNotMatchFilter)
+	suite.Require().False(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, matchingTx.Hash()))
+
+	// a transaction which isn't even a cosmos transaction is rejected
+	block = suite.makeBlockWithTxs(baseHeight+2, baseTime.Add(3*time.Second), tmtypes.Txs{tmtypes.Tx("not a tx")})
+	suite.Require().ErrorIs(submit(block), iqtypes.ErrTxDoesNotMatchFilter)
+
+	block = suite.makeBlockWithTxs(baseHeight+4, baseTime.Add(5*time.Second), tmtypes.Txs{matchingTx})
+	suite.Require().NoError(submit(block))
+	suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, res.Id, matchingTx.Hash()))
+}
+
+// encodeTx encodes an unsigned transaction with the msgs the way a remote chain does.
+func (suite *KeeperTestSuite) encodeTx(msgs ...types.Msg) tmtypes.Tx {
+	cdc := suite.GetNeutronZoneApp(suite.ChainA).AppCodec()
+
+	body := txtypes.TxBody{}
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		suite.Require().NoError(err)
+		body.Messages = append(body.Messages, anyMsg)
+	}
+
+	bodyBz, err := cdc.Marshal(&body)
+	suite.Require().NoError(err)
+	txBz, err := cdc.Marshal(&txtypes.TxRaw{BodyBytes: bodyBz})
+	suite.Require().NoError(err)
+
+	return txBz
+}
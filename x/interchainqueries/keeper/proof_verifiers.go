@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"bytes"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibccommitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	tendermintLightClientTypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// ProofVerifier verifies the KV results of interchain queries submitted for light clients of a certain type.
+type ProofVerifier interface {
+	// VerifyHeight checks that the results obtained at the height can be verified with the client and returns
+	// the consensus state they are verified against. It is loaded once for all the results of a message.
+	VerifyHeight(ctx sdk.Context, clientID string, clientState exported.ClientState, height exported.Height) (exported.ConsensusState, error)
+	// VerifyKVResult verifies the proof of the result obtained at the height against the consensus state returned
+	// by VerifyHeight. The value of a result proved to be absent is set to nil.
+	VerifyKVResult(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState, height exported.Height, result *types.StorageValue) error
+	// ConsensusTimestamp returns the remote chain time, in nanoseconds, of the consensus state the results obtained
+	// at the height are verified against.
+	ConsensusTimestamp(ctx sdk.Context, clientID string, clientState exported.ClientState, height exported.Height) (uint64, error)
+	// OnResultsVerified is called once all the KV results of the query obtained at the height are verified, so
+	// the verifier can make sure the same proofs are not accepted for the query again. The results are rejected
+	// if it returns an error.
+	OnResultsVerified(ctx sdk.Context, clientID string, clientState exported.ClientState, height exported.Height, queryID uint64) error
+}
+
+// RegisterProofVerifier sets the verifier used for the KV results submitted for clients of the client type.
+func (k Keeper) RegisterProofVerifier(clientType string, verifier ProofVerifier) {
+	k.proofVerifiers[clientType] = verifier
+}
+
+// getProofVerifier returns the client state of the client and the verifier registered for its type.
+func (k Keeper) getProofVerifier(ctx sdk.Context, clientID string) (exported.ClientState, ProofVerifier, error) {
+	clientState, err := k.GetClientState(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier, ok := k.proofVerifiers[clientState.ClientType()]
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(types.ErrUnsupportedClientType, "KV results can't be verified with %s clients", clientState.ClientType())
+	}
+
+	return clientState, verifier, nil
+}
+
+// TendermintProofVerifier verifies ICS-23 proofs of KV results against the app hash of the remote chain block
+// at height + 1, since the app hash of a block is committed to in the header of the next block.
+type TendermintProofVerifier struct {
+	ibcKeeper *ibckeeper.Keeper
+}
+
+func NewTendermintProofVerifier(ibcKeeper *ibckeeper.Keeper) TendermintProofVerifier {
+	return TendermintProofVerifier{ibcKeeper: ibcKeeper}
+}
+
+func (v TendermintProofVerifier) VerifyHeight(ctx sdk.Context, clientID string, _ exported.ClientState, height exported.Height) (exported.ConsensusState, error) {
+	return v.getConsensusState(ctx, clientID, height)
+}
+
+func (v TendermintProofVerifier) VerifyKVResult(ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState, _ exported.Height, result *types.StorageValue) error {
+	tmClientState, ok := clientState.(*tendermintLightClientTypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(ibcclienttypes.ErrInvalidClientType, "cannot cast ClientState interface into ClientState type")
+	}
+
+	proof, err := ibccommitmenttypes.ConvertProofs(result.Proof)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidType, "failed to convert crypto.ProofOps to MerkleProof: %v", err)
+	}
+
+	path := ibccommitmenttypes.NewMerklePath(result.StoragePrefix, string(result.Key))
+
+	// identify what kind proofs (non-existence proof always has *ics23.CommitmentProof_Nonexist as the first item) we got
+	// and call corresponding method to verify it
+	switch proof.GetProofs()[0].GetProof().(type) {
+	// we can get non-existence proof if someone queried some key which is not exists in the storage on remote chain
+	case *ics23.CommitmentProof_Nonexist:
+		if err := proof.VerifyNonMembership(tmClientState.ProofSpecs, consensusState.GetRoot(), path); err != nil {
+			ctx.Logger().Debug("VerifyKVResult: failed to VerifyNonMembership",
+				"error", err, "client_id", clientID, "path", path)
+			return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to verify proof: %v", err)
+		}
+		result.Value = nil
+	case *ics23.CommitmentProof_Exist:
+		if err := proof.VerifyMembership(tmClientState.ProofSpecs, consensusState.GetRoot(), path, result.Value); err != nil {
+			ctx.Logger().Debug("VerifyKVResult: failed to VerifyMembership",
+				"error", err, "client_id", clientID, "path", path)
+			return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to verify proof: %v", err)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "unknown proof type %T", proof.GetProofs()[0].GetProof())
+	}
+
+	return nil
+}
+
+func (v TendermintProofVerifier) ConsensusTimestamp(ctx sdk.Context, clientID string, _ exported.ClientState, height exported.Height) (uint64, error) {
+	consensusState, err := v.getConsensusState(ctx, clientID, height)
+	if err != nil {
+		return 0, err
+	}
+
+	return consensusState.GetTimestamp(), nil
+}
+
+func (v TendermintProofVerifier) OnResultsVerified(_ sdk.Context, _ string, _ exported.ClientState, _ exported.Height, _ uint64) error {
+	return nil
+}
+
+func (v TendermintProofVerifier) getConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, error) {
+	consensusHeight := ibcclienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+1)
+	consensusState, ok := v.ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, clientID, consensusHeight)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ibcclienttypes.ErrConsensusStateNotFound,
+			"failed to get consensus state for client %s at height %s", clientID, consensusHeight)
+	}
+
+	return consensusState, nil
+}
+
+// SoloMachineProofVerifier verifies the signatures a solo machine makes over KV results. The height of the results
+// is a sequence of the solo machine not lower than the sequence of its client, and the results must be signed with
+// the current public key of the solo machine as described in types.SoloMachineKVSignBytes. The client itself is
+// left untouched, its sequences belong to IBC. Instead, the last sequence the results of a query are verified at
+// is kept in the module store, and the results of the query must be obtained at a greater sequence, so the
+// signatures can't be replayed.
+type SoloMachineProofVerifier struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+}
+
+func NewSoloMachineProofVerifier(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) SoloMachineProofVerifier {
+	return SoloMachineProofVerifier{cdc: cdc, storeKey: storeKey}
+}
+
+func (v SoloMachineProofVerifier) VerifyHeight(_ sdk.Context, _ string, clientState exported.ClientState, height exported.Height) (exported.ConsensusState, error) {
+	smClientState, ok := clientState.(*solomachinetypes.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ibcclienttypes.ErrInvalidClientType, "cannot cast ClientState interface into solo machine ClientState type")
+	}
+
+	if height.GetRevisionNumber() != 0 || height.LT(smClientState.GetLatestHeight()) {
+		return nil, sdkerrors.Wrapf(ibcclienttypes.ErrInvalidHeight,
+			"results height %s is lower than the solo machine sequence %s", height, smClientState.GetLatestHeight())
+	}
+
+	if smClientState.ConsensusState == nil {
+		return nil, sdkerrors.Wrap(ibcclienttypes.ErrConsensusStateNotFound, "solo machine consensus state is empty")
+	}
+
+	return smClientState.ConsensusState, nil
+}
+
+func (v SoloMachineProofVerifier) VerifyKVResult(ctx sdk.Context, clientID string, _ exported.ClientState, consensusState exported.ConsensusState, height exported.Height, result *types.StorageValue) error {
+	smConsensusState, ok := consensusState.(*solomachinetypes.ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(ibcclienttypes.ErrInvalidConsensus, "cannot cast ConsensusState interface into solo machine ConsensusState type")
+	}
+
+	ops := result.GetProof().GetOps()
+	if len(ops) != 1 || ops[0].Type != types.SoloMachineProofOpType {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "solo machine proof must consist of a single %s operation", types.SoloMachineProofOpType)
+	}
+
+	var signature solomachinetypes.TimestampedSignatureData
+	if err := v.cdc.Unmarshal(ops[0].Data, &signature); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to unmarshal solo machine signature: %v", err)
+	}
+
+	if smConsensusState.GetTimestamp() > signature.Timestamp {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d > %d)",
+			smConsensusState.GetTimestamp(), signature.Timestamp)
+	}
+
+	sigData, err := solomachinetypes.UnmarshalSignatureData(v.cdc, signature.SignatureData)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to unmarshal solo machine signature data: %v", err)
+	}
+
+	publicKey, err := smConsensusState.GetPubKey()
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to get solo machine public key: %v", err)
+	}
+
+	signBytes, err := types.SoloMachineKVSignBytes(v.cdc, height.GetRevisionHeight(), signature.Timestamp,
+		smConsensusState.Diversifier, result.StoragePrefix, result.Key, result.Value)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to build solo machine sign bytes: %v", err)
+	}
+
+	if err := solomachinetypes.VerifySignature(publicKey, signBytes, sigData); err != nil {
+		ctx.Logger().Debug("VerifyKVResult: failed to verify solo machine signature",
+			"error", err, "client_id", clientID, "key", result.Key)
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "failed to verify proof: %v", err)
+	}
+
+	return nil
+}
+
+func (v SoloMachineProofVerifier) ConsensusTimestamp(_ sdk.Context, _ string, clientState exported.ClientState, _ exported.Height) (uint64, error) {
+	smClientState, ok := clientState.(*solomachinetypes.ClientState)
+	if !ok {
+		return 0, sdkerrors.Wrapf(ibcclienttypes.ErrInvalidClientType, "cannot cast ClientState interface into solo machine ClientState type")
+	}
+
+	if smClientState.ConsensusState == nil {
+		return 0, sdkerrors.Wrap(ibcclienttypes.ErrConsensusStateNotFound, "solo machine consensus state is empty")
+	}
+
+	return smClientState.ConsensusState.GetTimestamp(), nil
+}
+
+func (v SoloMachineProofVerifier) OnResultsVerified(ctx sdk.Context, clientID string, _ exported.ClientState, height exported.Height, queryID uint64) error {
+	store := ctx.KVStore(v.storeKey)
+	key := types.GetSoloMachineSequenceKey(queryID, clientID)
+
+	if bz := store.Get(key); bz != nil && height.GetRevisionHeight() <= sdk.BigEndianToUint64(bz) {
+		return sdkerrors.Wrapf(ibcclienttypes.ErrInvalidHeight,
+			"results of query %d have already been verified at solo machine %s sequence %d", queryID, clientID, sdk.BigEndianToUint64(bz))
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(height.GetRevisionHeight()))
+
+	return nil
+}
+
+// SetSoloMachineSequence sets the last solo machine sequence the results of the query are verified at.
+func (k Keeper) SetSoloMachineSequence(ctx sdk.Context, sequence types.SoloMachineSequence) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSoloMachineSequenceKey(sequence.QueryId, sequence.ClientId), sdk.Uint64ToBigEndian(sequence.Sequence))
+}
+
+// IterateSoloMachineSequences iterates over the last solo machine sequences the results of the queries are
+// verified at.
+func (k Keeper) IterateSoloMachineSequences(ctx sdk.Context, fn func(sequence types.SoloMachineSequence) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SoloMachineSequenceKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the keys consist of the query id and the client id
+		key := iterator.Key()
+		if fn(types.SoloMachineSequence{
+			QueryId:  sdk.BigEndianToUint64(key[:8]),
+			ClientId: string(key[8:]),
+			Sequence: sdk.BigEndianToUint64(iterator.Value()),
+		}) {
+			break
+		}
+	}
+}
+
+func (k Keeper) removeSoloMachineSequences(ctx sdk.Context, queryID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSoloMachineSequenceKeyPrefix(queryID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	var toRemove [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		toRemove = append(toRemove, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range toRemove {
+		store.Delete(key)
+	}
+}
+
+// LocalhostProofVerifier verifies KV results submitted for a localhost client by reading the stores of the chain
+// itself, so queries to the local state are served the same way as queries to the remote chains. The results
+// are checked against the current state, so the height of the results must be the current height of the chain,
+// and the proofs are ignored.
+type LocalhostProofVerifier struct {
+	ibcKeeper *ibckeeper.Keeper
+	storeKeys map[string]storetypes.StoreKey
+}
+
+func NewLocalhostProofVerifier(ibcKeeper *ibckeeper.Keeper, storeKeys map[string]storetypes.StoreKey) LocalhostProofVerifier {
+	return LocalhostProofVerifier{ibcKeeper: ibcKeeper, storeKeys: storeKeys}
+}
+
+// VerifyHeight returns no consensus state, the results are verified against the local stores.
+func (v LocalhostProofVerifier) VerifyHeight(ctx sdk.Context, _ string, _ exported.ClientState, height exported.Height) (exported.ConsensusState, error) {
+	return nil, v.verifyCurrentHeight(ctx, height)
+}
+
+func (v LocalhostProofVerifier) VerifyKVResult(ctx sdk.Context, _ string, _ exported.ClientState, _ exported.ConsensusState, height exported.Height, result *types.StorageValue) error {
+	if err := v.verifyCurrentHeight(ctx, height); err != nil {
+		return err
+	}
+
+	storeKey, ok := v.storeKeys[result.StoragePrefix]
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidSubmittedResult, "unknown local store %s", result.StoragePrefix)
+	}
+
+	value := ctx.KVStore(storeKey).Get(result.Key)
+	if !bytes.Equal(value, result.Value) {
+		return sdkerrors.Wrapf(types.ErrInvalidProof, "value of key %X is not equal to the value in the local store %s",
+			result.Key, result.StoragePrefix)
+	}
+	result.Value = value
+
+	return nil
+}
+
+// ConsensusTimestamp returns the time of the local block at the height. The time of the past blocks is taken from
+// the historical info kept by the staking module.
+func (v LocalhostProofVerifier) ConsensusTimestamp(ctx sdk.Context, clientID string, _ exported.ClientState, height exported.Height) (uint64, error) {
+	currentHeight := ibcclienttypes.NewHeight(ibcclienttypes.ParseChainID(ctx.ChainID()), uint64(ctx.BlockHeight()))
+	if height.EQ(currentHeight) {
+		return uint64(ctx.BlockTime().UnixNano()), nil
+	}
+
+	consensusState, err := v.ibcKeeper.ClientKeeper.GetSelfConsensusState(ctx,
+		ibcclienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()))
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ibcclienttypes.ErrConsensusStateNotFound,
+			"failed to get local block time for localhost client %s at height %s: %v", clientID, height, err)
+	}
+
+	return consensusState.GetTimestamp(), nil
+}
+
+func (v LocalhostProofVerifier) verifyCurrentHeight(ctx sdk.Context, height exported.Height) error {
+	currentHeight := ibcclienttypes.NewHeight(ibcclienttypes.ParseChainID(ctx.ChainID()), uint64(ctx.BlockHeight()))
+	if !height.EQ(currentHeight) {
+		return sdkerrors.Wrapf(ibcclienttypes.ErrInvalidHeight, "results height %s is not equal to the current height %s",
+			height, currentHeight)
+	}
+
+	return nil
+}
+
+func (v LocalhostProofVerifier) OnResultsVerified(_ sdk.Context, _ string, _ exported.ClientState, _ exported.Height, _ uint64) error {
+	return nil
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// setupClientConnection creates a client of the client state on chain A and an open connection on top of it.
+func (suite *KeeperTestSuite) setupClientConnection(ctx sdktypes.Context, clientState exported.ClientState, consensusState exported.ConsensusState) (string, string) {
+	ibcKeeper := suite.GetNeutronZoneApp(suite.ChainA).IBCKeeper
+	ibcKeeper.ClientKeeper.SetParams(ctx, ibcclienttypes.NewParams(exported.Tendermint, exported.Solomachine, exported.Localhost))
+
+	clientID, err := ibcKeeper.ClientKeeper.CreateClient(ctx, clientState, consensusState)
+	suite.Require().NoError(err)
+
+	connectionID := ibcKeeper.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		clientID,
+		connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()),
+		0,
+	))
+
+	return clientID, connectionID
+}
+
+func (suite *KeeperTestSuite) TestSoloMachineKVResults() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		owner    = wasmKeeper.RandomAccountAddress(suite.T())
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper = app.InterchainQueriesKeeper
+		msgSrv   = keeper.NewMsgServerImpl(iqkeeper)
+		cdc      = app.AppCodec()
+		solo     = ibctesting.NewSolomachine(suite.T(), cdc, "solomachine", "diversifier", 1)
+		kvKey    = &iqtypes.KVKey{Path: banktypes.StoreKey, Key: []byte("key")}
+	)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	clientID, connectionID := suite.setupClientConnection(ctx, solo.ClientState(), solo.ConsensusState())
+
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: connectionID,
+		Keys:         []*iqtypes.KVKey{kvKey},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	value := []byte("value")
+	signProof := func(sequence uint64, value []byte) *crypto.ProofOps {
+		signBytes, err := iqtypes.SoloMachineKVSignBytes(cdc, sequence, solo.Time, solo.Diversifier, kvKey.Path, kvKey.Key, value)
+		suite.Require().NoError(err)
+		signature, err := cdc.Marshal(&solomachinetypes.TimestampedSignatureData{
+			SignatureData: solo.GenerateSignature(signBytes),
+			Timestamp:     solo.Time,
+		})
+		suite.Require().NoError(err)
+		return &crypto.ProofOps{Ops: []crypto.ProofOp{{Type: iqtypes.SoloMachineProofOpType, Key: kvKey.Key, Data: signature}}}
+	}
+	submit := func(height uint64, proof *crypto.ProofOps) error {
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   senderAddress.String(),
+			ClientId: clientID,
+			Result: &iqtypes.QueryResult{
+				KvResults: []*iqtypes.StorageValue{{
+					Key:           kvKey.Key,
+					Proof:         proof,
+					Value:         value,
+					StoragePrefix: kvKey.Path,
+				}},
+				Height: height,
+			},
+		})
+		return err
+	}
+
+	// the results can't be obtained before the current sequence of the solo machine client
+	err = submit(solo.Sequence-1, signProof(solo.Sequence-1, value))
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrInvalidHeight)
+
+	// the signature is made over another value
+	err = submit(solo.Sequence, signProof(solo.Sequence, []byte("another value")))
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidProof)
+
+	proof := signProof(solo.Sequence, value)
+	err = submit(solo.Sequence, proof)
+	suite.Require().NoError(err)
+
+	result, err := iqkeeper.GetQueryResultByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(solo.Sequence, result.Height)
+	suite.Require().Equal(value, result.KvResults[0].Value)
+
+	// the sequences of the client belong to IBC, the client is left untouched
+	clientState, ok := app.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(ok)
+	suite.Require().Equal(ibcclienttypes.NewHeight(0, solo.Sequence), clientState.GetLatestHeight())
+
+	// the signature can't be replayed, the results of the query must be obtained at a greater sequence
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = submit(solo.Sequence, proof)
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrInvalidHeight)
+
+	proof = signProof(solo.Sequence+1, value)
+	err = submit(solo.Sequence+1, proof)
+	suite.Require().NoError(err)
+
+	// nor can it be replayed once the query is moved to another connection and back
+	for _, connection := range []string{suite.Path.EndpointA.ConnectionID, connectionID} {
+		_, err = msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+			QueryId:         res.Id,
+			NewConnectionId: connection,
+			Sender:          owner.String(),
+		})
+		suite.Require().NoError(err)
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = submit(solo.Sequence+1, proof)
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrInvalidHeight)
+
+	err = submit(solo.Sequence+2, signProof(solo.Sequence+2, value))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestLocalhostKVResults() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		owner    = wasmKeeper.RandomAccountAddress(suite.T())
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper = app.InterchainQueriesKeeper
+		msgSrv   = keeper.NewMsgServerImpl(iqkeeper)
+		revision = ibcclienttypes.ParseChainID(ctx.ChainID())
+	)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	clientID, connectionID := suite.setupClientConnection(ctx,
+		localhosttypes.NewClientState(ctx.ChainID(), ibcclienttypes.NewHeight(revision, uint64(ctx.BlockHeight()))), nil)
+
+	balanceKey, err := iqtypes.NewBankBalanceKVKey(senderAddress.String(), sdktypes.DefaultBondDenom)
+	suite.Require().NoError(err)
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: connectionID,
+		Keys:         []*iqtypes.KVKey{&balanceKey},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	submit := func(height uint64, value []byte) error {
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   senderAddress.String(),
+			ClientId: clientID,
+			Result: &iqtypes.QueryResult{
+				KvResults: []*iqtypes.StorageValue{{
+					Key:           balanceKey.Key,
+					Value:         value,
+					StoragePrefix: balanceKey.Path,
+				}},
+				Height:   height,
+				Revision: revision,
+			},
+		})
+		return err
+	}
+
+	value := ctx.KVStore(app.GetKey(banktypes.StoreKey)).Get(balanceKey.Key)
+	suite.Require().NotEmpty(value)
+
+	// the results are checked against the current state, so they can't be submitted for any other height
+	err = submit(uint64(ctx.BlockHeight()-1), value)
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrInvalidHeight)
+	err = submit(uint64(ctx.BlockHeight()+1), value)
+	suite.Require().ErrorIs(err, ibcclienttypes.ErrInvalidHeight)
+
+	err = submit(uint64(ctx.BlockHeight()), []byte("another value"))
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidProof)
+
+	err = submit(uint64(ctx.BlockHeight()), value)
+	suite.Require().NoError(err)
+
+	result, err := iqkeeper.GetQueryResultByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), result.Height)
+	suite.Require().Equal(value, result.KvResults[0].Value)
+
+	// the remote block time of the results is the local block time
+	query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	timestamp, err := iqkeeper.GetLastResultRemoteTimestamp(ctx, query)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(ctx.BlockTime().UnixNano()), timestamp)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// SetQueryResultHistoryRecord stores the result in the result history of the query with id under the
+// result remote revision and height, without pruning the history. It is used to restore the history from genesis.
+func (k Keeper) SetQueryResultHistoryRecord(ctx sdk.Context, id uint64, result *types.QueryResult) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(result)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrProtoMarshal, "failed to marshal result: %v", err)
+	}
+
+	store.Set(types.GetQueryResultHistoryKey(id, result.Revision, result.Height), bz)
+
+	return nil
+}
+
+// GetQueryResultHistory returns the results from the result history of the query with id obtained at
+// the remote revision which remote heights are in the [minHeight; maxHeight] range, ordered by remote
+// height. Zero maxHeight means there is no upper bound.
+func (k Keeper) GetQueryResultHistory(ctx sdk.Context, id uint64, revision uint64, minHeight uint64, maxHeight uint64) ([]types.QueryResult, error) {
+	results := make([]types.QueryResult, 0)
+	if maxHeight != 0 && maxHeight < minHeight {
+		return results, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueryResultHistoryRevisionKeyPrefix(id, revision))
+
+	var end []byte
+	if maxHeight != 0 && maxHeight < ^uint64(0) {
+		end = sdk.Uint64ToBigEndian(maxHeight + 1)
+	}
+
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(minHeight), end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.QueryResult
+		if err := k.cdc.Unmarshal(iterator.Value(), &result); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unmarshal query result: %v", err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// IterateQueryResultHistory iterates over the result histories of all the KV queries.
+func (k Keeper) IterateQueryResultHistory(ctx sdk.Context, fn func(queryID uint64, result types.QueryResult) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueryResultHistoryKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.QueryResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+
+		if fn(sdk.BigEndianToUint64(iterator.Key()[:8]), result) {
+			break
+		}
+	}
+}
+
+// saveQueryResultToHistory adds the result to the result history of the query and removes the oldest
+// results exceeding the query ResultHistorySize.
+func (k Keeper) saveQueryResultToHistory(ctx sdk.Context, id uint64, result *types.QueryResult) error {
+	query, err := k.GetQueryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if query.ResultHistorySize == 0 {
+		return nil
+	}
+
+	if err := k.SetQueryResultHistoryRecord(ctx, id, result); err != nil {
+		return err
+	}
+
+	k.pruneQueryResultHistory(ctx, id, query.ResultHistorySize)
+
+	return nil
+}
+
+// pruneQueryResultHistory keeps only size latest results in the result history of the query. The results
+// are ordered by revision first, so the results of the remote chain upgraded to a new revision are the
+// latest ones.
+func (k Keeper) pruneQueryResultHistory(ctx sdk.Context, id uint64, size uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueryResultHistoryKeyPrefix(id))
+	iterator := sdk.KVStoreReversePrefixIterator(store, nil)
+
+	var (
+		kept     uint64
+		toRemove [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if kept < size {
+			kept++
+			continue
+		}
+		toRemove = append(toRemove, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range toRemove {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) removeQueryResultHistory(ctx sdk.Context, id uint64) {
+	k.pruneQueryResultHistory(ctx, id, 0)
+}
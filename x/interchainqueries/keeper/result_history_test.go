@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/neutron-org/neutron/testutil/interchainqueries/keeper"
+	"github.com/neutron-org/neutron/testutil/interchainqueries/sample"
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func TestQueryResultHistory(t *testing.T) {
+	k, ctx := testkeeper.InterchainQueriesKeeper(t)
+
+	require.NoError(t, k.SaveQuery(ctx, types.RegisteredQuery{
+		Id:                1,
+		Owner:             sample.AccAddress(),
+		QueryType:         string(types.InterchainQueryTypeKV),
+		ResultHistorySize: 3,
+	}))
+	require.NoError(t, k.SaveQuery(ctx, types.RegisteredQuery{
+		Id:        2,
+		Owner:     sample.AccAddress(),
+		QueryType: string(types.InterchainQueryTypeKV),
+	}))
+
+	for height := uint64(10); height < 15; height++ {
+		for _, id := range []uint64{1, 2} {
+			require.NoError(t, k.SaveKVQueryResult(ctx, id, &types.QueryResult{
+				KvResults: []*types.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte{byte(height)}}},
+				Height:    height,
+			}))
+		}
+	}
+
+	heights := func(results []types.QueryResult) []uint64 {
+		out := make([]uint64, 0, len(results))
+		for _, result := range results {
+			out = append(out, result.Height)
+		}
+		return out
+	}
+
+	// only the last 3 results are kept
+	results, err := k.GetQueryResultHistory(ctx, 1, 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12, 13, 14}, heights(results))
+	require.Equal(t, []byte{13}, results[1].KvResults[0].Value)
+
+	results, err = k.GetQueryResultHistory(ctx, 1, 0, 13, 13)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{13}, heights(results))
+
+	results, err = k.GetQueryResultHistory(ctx, 1, 0, 0, 12)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{12}, heights(results))
+
+	results, err = k.GetQueryResultHistory(ctx, 1, 0, 14, 13)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// no history is kept for a query with zero result history size
+	results, err = k.GetQueryResultHistory(ctx, 2, 0, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestQueryResultHistoryRevisionUpgrade(t *testing.T) {
+	k, ctx := testkeeper.InterchainQueriesKeeper(t)
+
+	require.NoError(t, k.SaveQuery(ctx, types.RegisteredQuery{
+		Id:                1,
+		Owner:             sample.AccAddress(),
+		QueryType:         string(types.InterchainQueryTypeKV),
+		ResultHistorySize: 2,
+	}))
+
+	save := func(revision, height uint64) {
+		require.NoError(t, k.SaveKVQueryResult(ctx, 1, &types.QueryResult{
+			KvResults: []*types.StorageValue{{StoragePrefix: "bank", Key: []byte("key"), Value: []byte{byte(height)}}},
+			Height:    height,
+			Revision:  revision,
+		}))
+	}
+
+	save(1, 100)
+	save(1, 101)
+	// the remote chain is upgraded to a new revision and its heights start over
+	save(2, 1)
+
+	results, err := k.GetQueryResultHistory(ctx, 1, 2, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, uint64(1), results[0].Height)
+
+	// the results of the previous revision are the oldest ones and they are pruned first
+	results, err = k.GetQueryResultHistory(ctx, 1, 1, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, uint64(101), results[0].Height)
+
+	save(2, 2)
+	results, err = k.GetQueryResultHistory(ctx, 1, 1, 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
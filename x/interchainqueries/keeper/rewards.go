@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// FundQueryReward transfers the amount of coins from the sender to the reward escrow of the query.
+func (k Keeper) FundQueryReward(ctx sdk.Context, queryID uint64, sender sdk.AccAddress, amount sdk.Coins) error {
+	query, err := k.GetQueryByID(ctx, queryID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get query by query id: %v", err)
+	}
+
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return sdkerrors.Wrapf(err, "failed to collect reward funds")
+	}
+
+	query.RewardEscrow = query.RewardEscrow.Add(amount...)
+	if err := k.SaveQuery(ctx, *query); err != nil {
+		return sdkerrors.Wrapf(err, "failed to save query: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRewardFunded),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardEscrow, query.RewardEscrow.String()),
+	))
+
+	return nil
+}
+
+// PayRelayerReward pays the submission reward of the query to the relayer from the query reward escrow.
+// If there are not enough funds in the escrow, nothing is paid and an event notifying that the escrow is
+// out of funds is emitted.
+func (k Keeper) PayRelayerReward(ctx sdk.Context, queryID uint64, relayer sdk.AccAddress) error {
+	query, err := k.GetQueryByID(ctx, queryID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get query by query id: %v", err)
+	}
+
+	if query.SubmissionReward.IsZero() {
+		return nil
+	}
+
+	escrowLeft, insufficient := query.RewardEscrow.SafeSub(query.SubmissionReward)
+	if insufficient {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeNeutronMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRewardEscrowOutOfFunds),
+			sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, query.Owner),
+			sdk.NewAttribute(types.AttributeKeySubmissionReward, query.SubmissionReward.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardEscrow, query.RewardEscrow.String()),
+		))
+		k.Logger(ctx).Debug("PayRelayerReward: reward escrow is out of funds", "query_id", query.Id)
+		return nil
+	}
+
+	if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, query.SubmissionReward); err != nil {
+		return sdkerrors.Wrapf(err, "failed to pay submission reward")
+	}
+
+	query.RewardEscrow = escrowLeft
+	if err := k.SaveQuery(ctx, *query); err != nil {
+		return sdkerrors.Wrapf(err, "failed to save query: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueRelayerRewarded),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+		sdk.NewAttribute(types.AttributeKeySubmissionReward, query.SubmissionReward.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardEscrow, query.RewardEscrow.String()),
+	))
+
+	return nil
+}
+
+// mustRefundRewardEscrow returns the funds left in the reward escrow of the removed query to the query owner.
+func (k Keeper) mustRefundRewardEscrow(ctx sdk.Context, query *types.RegisteredQuery) {
+	if query.RewardEscrow.IsZero() {
+		return
+	}
+
+	owner, err := query.GetOwnerAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	k.MustPayOutDeposit(ctx, query.RewardEscrow, owner)
+}
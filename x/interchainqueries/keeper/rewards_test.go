@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func (suite *KeeperTestSuite) TestRelayerRewards() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = wasmKeeper.RandomAccountAddress(suite.T())
+		neutronApp    = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = neutronApp.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		reward        = sdktypes.NewCoins(sdktypes.NewCoin(sdktypes.DefaultBondDenom, sdktypes.NewInt(1_000)))
+		funds         = sdktypes.NewCoins(sdktypes.NewCoin(sdktypes.DefaultBondDenom, sdktypes.NewInt(1_500)))
+	)
+
+	// Store code and instantiate reflect contract.
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	// Top up contract address with native coins for deposit
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	clientKey := host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:     suite.Path.EndpointA.ConnectionID,
+		Keys:             []*iqtypes.KVKey{{Path: host.StoreKey, Key: clientKey}},
+		QueryType:        string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod:     1,
+		Sender:           contractAddress.String(),
+		SubmissionReward: reward,
+	})
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.FundQueryReward(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgFundQueryReward{
+		QueryId: res.Id,
+		Amount:  funds,
+		Sender:  senderAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reward, query.SubmissionReward)
+	suite.Require().Equal(funds, query.RewardEscrow)
+
+	submitResult := func() sdktypes.Context {
+		suite.Require().NoError(suite.Path.EndpointA.UpdateClient())
+		ctx := ctx.WithEventManager(sdktypes.NewEventManager()).WithBlockHeight(ctx.BlockHeight() + 1)
+
+		resp := suite.ChainB.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+			Height: suite.ChainB.LastHeader.Header.Height - 1,
+			Data:   clientKey,
+			Prove:  true,
+		})
+
+		msg := iqtypes.MsgSubmitQueryResult{
+			QueryId:  res.Id,
+			Sender:   relayer.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result: &iqtypes.QueryResult{
+				KvResults: []*iqtypes.StorageValue{{
+					Key:           resp.Key,
+					Proof:         resp.ProofOps,
+					Value:         resp.Value,
+					StoragePrefix: host.StoreKey,
+				}},
+				Height:   uint64(resp.Height),
+				Revision: suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+			},
+		}
+
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &msg)
+		suite.Require().NoError(err)
+
+		// the same result can't be submitted again to get one more reward
+		_, err = msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx.WithBlockHeight(ctx.BlockHeight()+1)), &msg)
+		suite.Require().ErrorIs(err, iqtypes.ErrInvalidHeight)
+
+		return ctx
+	}
+	hasEvent := func(ctx sdktypes.Context, action string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == sdktypes.AttributeKeyAction && string(attr.Value) == action {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// the relayer is paid from the escrow
+	ctx = submitResult()
+	suite.Require().True(hasEvent(ctx, iqtypes.AttributeValueRelayerRewarded))
+	suite.Require().Equal(sdktypes.NewInt(1_000), neutronApp.BankKeeper.GetBalance(ctx, relayer, sdktypes.DefaultBondDenom).Amount)
+
+	query, err = iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdktypes.NewCoins(sdktypes.NewCoin(sdktypes.DefaultBondDenom, sdktypes.NewInt(500))), query.RewardEscrow)
+
+	// the escrow is out of funds, so the relayer is not paid anymore
+	suite.Coordinator.CommitBlock(suite.ChainB)
+	ctx = submitResult()
+	suite.Require().False(hasEvent(ctx, iqtypes.AttributeValueRelayerRewarded))
+	suite.Require().True(hasEvent(ctx, iqtypes.AttributeValueRewardEscrowOutOfFunds))
+	suite.Require().Equal(sdktypes.NewInt(1_000), neutronApp.BankKeeper.GetBalance(ctx, relayer, sdktypes.DefaultBondDenom).Amount)
+
+	// the rest of the escrow is returned to the owner along with the deposit
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: res.Id,
+		Sender:  contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdktypes.NewInt(1_000_500), neutronApp.BankKeeper.GetBalance(ctx, contractAddress, sdktypes.DefaultBondDenom).Amount)
+}
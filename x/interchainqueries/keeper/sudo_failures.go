@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+
+	"github.com/neutron-org/neutron/internal/sudo"
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// GetLastSudoFailureID returns the id of the last recorded sudo failure.
+func (k Keeper) GetLastSudoFailureID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastSudoFailureIdKey)
+	if bytes == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bytes)
+}
+
+// SetLastSudoFailureID sets the id of the last recorded sudo failure.
+func (k Keeper) SetLastSudoFailureID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSudoFailureIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetSudoFailure stores the sudo failure under its query id and failure id.
+func (k Keeper) SetSudoFailure(ctx sdk.Context, failure types.SudoFailure) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.Marshal(&failure)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrProtoMarshal, "failed to marshal sudo failure: %v", err)
+	}
+
+	store.Set(types.GetSudoFailureKey(failure.QueryId, failure.Id), bz)
+
+	return nil
+}
+
+// GetSudoFailure returns the sudo failure with failureID recorded for the query with queryID.
+func (k Keeper) GetSudoFailure(ctx sdk.Context, queryID uint64, failureID uint64) (*types.SudoFailure, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSudoFailureKey(queryID, failureID))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(types.ErrSudoFailureNotFound, "there is no sudo failure with id %d for query %d", failureID, queryID)
+	}
+
+	var failure types.SudoFailure
+	if err := k.cdc.Unmarshal(bz, &failure); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to unmarshal sudo failure: %v", err)
+	}
+
+	return &failure, nil
+}
+
+// IterateSudoFailures iterates over the sudo failures of all the queries.
+func (k Keeper) IterateSudoFailures(ctx sdk.Context, fn func(failure types.SudoFailure) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SudoFailureKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var failure types.SudoFailure
+		k.cdc.MustUnmarshal(iterator.Value(), &failure)
+
+		if fn(failure) {
+			break
+		}
+	}
+}
+
+// RetrySudoFailure passes the query result of the recorded sudo failure to the sudo handler of the current
+// query owner contract, the query might have been transferred to another owner since the failure. As the
+// initial call, the contract gas is limited by the SudoCallGasLimit param, and it is paid by the sender of
+// the retry. The failure is removed once the contract processes the query result successfully.
+func (k Keeper) RetrySudoFailure(ctx sdk.Context, queryID uint64, failureID uint64, sender sdk.AccAddress) error {
+	failure, err := k.GetSudoFailure(ctx, queryID, failureID)
+	if err != nil {
+		return err
+	}
+
+	query, err := k.GetQueryByID(ctx, queryID)
+	if err != nil {
+		return err
+	}
+
+	contract, err := query.GetOwnerAddress()
+	if err != nil {
+		return err
+	}
+
+	if err := k.trySudo(ctx, k.sudoPayload(contract, failure.Payload)); err != nil {
+		return sdkerrors.Wrapf(err, "contract %s rejected query result (query_id: %d, failure_id: %d)",
+			contract, queryID, failureID)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetSudoFailureKey(queryID, failureID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSudoFailureRetried),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(queryID, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, query.Owner),
+		sdk.NewAttribute(types.AttributeKeyFailureID, strconv.FormatUint(failureID, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	))
+
+	return nil
+}
+
+func (k Keeper) removeSudoFailures(ctx sdk.Context, queryID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSudoFailureKeyPrefix(queryID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	var toRemove [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		toRemove = append(toRemove, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range toRemove {
+		store.Delete(key)
+	}
+}
+
+// sudoKVQueryResult lets the query owner contract process the submitted KV query result obtained at the
+// remote height.
+func (k Keeper) sudoKVQueryResult(ctx sdk.Context, contract sdk.AccAddress, queryID uint64, height ibcclienttypes.Height) error {
+	msg, err := sudo.NewKVQueryResultMessage(queryID, height.RevisionHeight, height.RevisionNumber)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInternal, "failed to build sudo message: %v", err)
+	}
+
+	k.callSudo(ctx, contract, queryID, msg, func(ctx sdk.Context) error {
+		_, err := k.sudoHandler.SudoKVQueryResult(ctx, contract, queryID, height.RevisionHeight, height.RevisionNumber)
+		return err
+	})
+
+	return nil
+}
+
+// sudoTxQueryResult lets the query owner contract process the submitted transaction.
+func (k Keeper) sudoTxQueryResult(ctx sdk.Context, contract sdk.AccAddress, queryID uint64, height int64, data []byte) error {
+	msg, err := sudo.NewTxQueryResultMessage(queryID, height, data)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInternal, "failed to build sudo message: %v", err)
+	}
+
+	k.callSudo(ctx, contract, queryID, msg, func(ctx sdk.Context) error {
+		_, err := k.sudoHandler.SudoTxQueryResult(ctx, contract, queryID, height, data)
+		return err
+	})
+
+	return nil
+}
+
+// sudoPayload returns the call passing the already built msg to the sudo handler of the contract.
+func (k Keeper) sudoPayload(contract sdk.AccAddress, msg []byte) func(ctx sdk.Context) error {
+	return func(ctx sdk.Context) error {
+		_, err := k.sudoHandler.Sudo(ctx, contract, msg)
+		return err
+	}
+}
+
+// callSudo makes the call passing the msg to the sudo handler of the query owner contract, nothing is done
+// if the owner isn't a contract. The contract can't make the result submission fail: the changes made by the
+// contract are committed only if the call succeeds, otherwise the failure is recorded along with the msg.
+func (k Keeper) callSudo(ctx sdk.Context, contract sdk.AccAddress, queryID uint64, msg []byte, call func(ctx sdk.Context) error) {
+	// the queries owned by externally owned accounts only keep their results, there is nobody to call back
+	if !k.wasmKeeper.HasContractInfo(ctx, contract) {
+		ctx.Logger().Debug("callSudo: query owner is not a contract, callback is skipped",
+			"query_id", queryID, "owner", contract)
+		return
+	}
+
+	err := k.trySudo(ctx, call)
+	if err == nil {
+		return
+	}
+
+	ctx.Logger().Debug("callSudo: contract has failed to process query result",
+		"error", err, "query_id", queryID, "contract", contract)
+
+	failure := types.SudoFailure{
+		Id:       k.GetLastSudoFailureID(ctx) + 1,
+		QueryId:  queryID,
+		Contract: contract.String(),
+		Payload:  msg,
+		Error:    err.Error(),
+		Height:   uint64(ctx.BlockHeight()),
+	}
+	if err := k.SetSudoFailure(ctx, failure); err != nil { // should never happen as the failure is always marshallable
+		panic(err.Error())
+	}
+	k.SetLastSudoFailureID(ctx, failure.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueSudoFailed),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(queryID, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, failure.Contract),
+		sdk.NewAttribute(types.AttributeKeyFailureID, strconv.FormatUint(failure.Id, 10)),
+	))
+}
+
+// trySudo makes the call to the sudo handler of a contract in a cached context with the gas limited by the
+// SudoCallGasLimit param. The gas spent by the contract is charged from the parent context in any case.
+func (k Keeper) trySudo(ctx sdk.Context, call func(ctx sdk.Context) error) (err error) {
+	gasLimit := k.GetParams(ctx).SudoCallGasLimit
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "contract has run out of gas in %s (gas limit: %d)", outOfGas.Descriptor, gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "sudo call")
+	}()
+
+	if err := call(cacheCtx); err != nil {
+		return err
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/neutron-org/neutron/internal/sudo"
+	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func (suite *KeeperTestSuite) TestSudoFailures() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		clientKey     = host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	)
+
+	// Store code and instantiate reflect contract.
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	kvQuery, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: host.StoreKey, Key: clientKey}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	txQuery, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:       suite.Path.EndpointA.ConnectionID,
+		TransactionsFilter: "[]",
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		UpdatePeriod:       1,
+		Sender:             contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	submitKVResult := func() {
+		suite.Require().NoError(suite.Path.EndpointA.UpdateClient())
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+		resp := suite.ChainB.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+			Height: suite.ChainB.LastHeader.Header.Height - 1,
+			Data:   clientKey,
+			Prove:  true,
+		})
+
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  kvQuery.Id,
+			Sender:   contractOwner.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result: &iqtypes.QueryResult{
+				KvResults: []*iqtypes.StorageValue{{
+					Key:           resp.Key,
+					Proof:         resp.ProofOps,
+					Value:         resp.Value,
+					StoragePrefix: host.StoreKey,
+				}},
+				Height:           uint64(resp.Height),
+				Revision:         suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+				AllowKvCallbacks: true,
+			},
+		})
+		suite.Require().NoError(err)
+
+		result, err := iqkeeper.GetQueryResultByID(ctx, kvQuery.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(resp.Height), result.Height)
+	}
+
+	// the contract fails to process the KV query result, the result is saved and the failure is recorded
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	submitKVResult()
+	suite.Require().Equal(uint64(1), iqkeeper.GetLastSudoFailureID(ctx))
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueSudoFailed))
+
+	kvFailure, err := iqkeeper.GetSudoFailure(ctx, kvQuery.Id, 1)
+	suite.Require().NoError(err)
+	kvResult, err := iqkeeper.GetQueryResultByID(ctx, kvQuery.Id)
+	suite.Require().NoError(err)
+	// the payload keeps the remote height of the result, so the contract can order it against newer results
+	expectedPayload, err := sudo.NewKVQueryResultMessage(kvQuery.Id, kvResult.Height, kvResult.Revision)
+	suite.Require().NoError(err)
+	suite.Require().Equal(contractAddress.String(), kvFailure.Contract)
+	suite.Require().Equal(expectedPayload, kvFailure.Payload)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), kvFailure.Height)
+	suite.Require().Contains(kvFailure.Error, "unknown variant `kv_query_result`")
+
+	baseTime := suite.Coordinator.CurrentTime
+	baseHeight := suite.ChainB.CurrentHeader.Height + 10
+	ctx = ctx.WithBlockTime(baseTime)
+	submitTx := func(height int64, timestamp time.Time, tx tmtypes.Tx) {
+		_, err := msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+			QueryId:  txQuery.Id,
+			Sender:   contractOwner.String(),
+			ClientId: suite.Path.EndpointA.ClientID,
+			Result:   &iqtypes.QueryResult{Block: suite.makeBlockWithTxs(height, timestamp, tmtypes.Txs{tx})},
+		})
+		suite.Require().NoError(err)
+		suite.Require().True(iqkeeper.CheckTransactionIsAlreadyProcessed(ctx, txQuery.Id, tx.Hash()))
+	}
+
+	// the contract processes the transaction successfully, no failure is recorded
+	submitTx(baseHeight, baseTime.Add(time.Second), tmtypes.Tx("first tx"))
+	suite.Require().Equal(uint64(1), iqkeeper.GetLastSudoFailureID(ctx))
+
+	// the contract runs out of gas, the transaction is processed and the failure is recorded
+	params := iqkeeper.GetParams(ctx)
+	params.SudoCallGasLimit = 1
+	iqkeeper.SetParams(ctx, params)
+
+	submitTx(baseHeight+2, baseTime.Add(3*time.Second), tmtypes.Tx("second tx"))
+	suite.Require().Equal(uint64(2), iqkeeper.GetLastSudoFailureID(ctx))
+
+	failure, err := iqkeeper.GetSudoFailure(ctx, txQuery.Id, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(txQuery.Id, failure.QueryId)
+	suite.Require().Contains(failure.Error, sdkerrors.ErrOutOfGas.Error())
+
+	res, err := iqkeeper.SudoFailures(sdktypes.WrapSDKContext(ctx), &iqtypes.QuerySudoFailuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Failures, 2)
+
+	res, err = iqkeeper.SudoFailures(sdktypes.WrapSDKContext(ctx), &iqtypes.QuerySudoFailuresRequest{QueryId: txQuery.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]iqtypes.SudoFailure{*failure}, res.Failures)
+
+	params.SudoCallGasLimit = iqtypes.DefaultSudoCallGasLimit
+	iqkeeper.SetParams(ctx, params)
+
+	// the failure can't be retried until the contract is able to process the result
+	anyone := wasmKeeper.RandomAccountAddress(suite.T())
+	_, err = msgSrv.RetrySudoFailure(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRetrySudoFailure{
+		QueryId:   kvQuery.Id,
+		FailureId: kvFailure.Id,
+		Sender:    anyone.String(),
+	})
+	suite.Require().ErrorContains(err, "unknown variant `kv_query_result`")
+	_, err = iqkeeper.GetSudoFailure(ctx, kvQuery.Id, kvFailure.Id)
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.RetrySudoFailure(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRetrySudoFailure{
+		QueryId:   kvQuery.Id,
+		FailureId: failure.Id,
+		Sender:    anyone.String(),
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrSudoFailureNotFound)
+
+	// the retry is limited by the sudo call gas limit as well
+	params.SudoCallGasLimit = 1
+	iqkeeper.SetParams(ctx, params)
+	_, err = msgSrv.RetrySudoFailure(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRetrySudoFailure{
+		QueryId:   txQuery.Id,
+		FailureId: failure.Id,
+		Sender:    anyone.String(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+	_, err = iqkeeper.GetSudoFailure(ctx, txQuery.Id, failure.Id)
+	suite.Require().NoError(err)
+
+	// the query is transferred to another contract, the result is retried with the current owner
+	newOwner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+	_, err = msgSrv.TransferInterchainQueryOwnership(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgTransferInterchainQueryOwnership{
+		QueryId:  txQuery.Id,
+		NewOwner: newOwner.String(),
+		Sender:   contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the retried failure is removed once the contract has enough gas to process the result
+	params.SudoCallGasLimit = iqtypes.DefaultSudoCallGasLimit
+	iqkeeper.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	_, err = msgSrv.RetrySudoFailure(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRetrySudoFailure{
+		QueryId:   txQuery.Id,
+		FailureId: failure.Id,
+		Sender:    anyone.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueSudoFailureRetried))
+	suite.Require().Equal(newOwner.String(), eventAttribute(ctx, iqtypes.AttributeValueSudoFailureRetried, iqtypes.AttributeKeyContract))
+	_, err = iqkeeper.GetSudoFailure(ctx, txQuery.Id, failure.Id)
+	suite.Require().ErrorIs(err, iqtypes.ErrSudoFailureNotFound)
+
+	// the failures are removed along with the query
+	_, err = msgSrv.RemoveInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: kvQuery.Id,
+		Sender:  contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+	_, err = iqkeeper.GetSudoFailure(ctx, kvQuery.Id, 1)
+	suite.Require().ErrorIs(err, iqtypes.ErrSudoFailureNotFound)
+}
+
+// eventAttribute returns the value of the attribute of the first event with the action.
+func eventAttribute(ctx sdktypes.Context, action string, key string) string {
+	for _, event := range ctx.EventManager().Events() {
+		if !hasAttribute(event, sdktypes.AttributeKeyAction, action) {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				return string(attr.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func hasAttribute(event sdktypes.Event, key string, value string) bool {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key && string(attr.Value) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAction(ctx sdktypes.Context, action string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdktypes.AttributeKeyAction && string(attr.Value) == action {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/neutron-org/neutron/internal/sudo"
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// CheckQueryConnectionClients checks the status of the IBC clients of the connections with registered queries,
+// starting right after the last checked one. The queries registered over a connection are suspended once its
+// client is frozen or expired, and resumed once the client is active again. The owners of the queries are notified
+// with the query_suspended and query_resumed sudo messages. Each checked connection and each handled query of a
+// connection count towards the Params.ClientStatusChecksPerBlock limit, the queries left once the limit is reached
+// are handled in the next blocks.
+func (k Keeper) CheckQueryConnectionClients(ctx sdk.Context) {
+	limit := k.GetParams(ctx).ClientStatusChecksPerBlock
+	if limit == 0 {
+		return
+	}
+
+	var start []byte
+	if lastChecked := k.getLastStatusCheckedConnection(ctx); lastChecked != "" {
+		start = sdk.PrefixEndBytes(address.MustLengthPrefix([]byte(lastChecked)))
+	}
+
+	for checks := uint64(0); checks < limit; {
+		connectionID, ok := k.getNextQueryConnection(ctx, start)
+		if !ok {
+			// the next check starts from the beginning of the connections list
+			k.setLastStatusCheckedConnection(ctx, "")
+			return
+		}
+
+		handled, done := k.checkConnectionClient(ctx, connectionID, limit-checks)
+		checks += handled
+		if !done {
+			// the connection is checked again in the next block to handle the rest of its queries
+			return
+		}
+
+		k.setLastStatusCheckedConnection(ctx, connectionID)
+		start = sdk.PrefixEndBytes(address.MustLengthPrefix([]byte(connectionID)))
+	}
+}
+
+// getNextQueryConnection returns the first connection with registered queries starting from the start key of
+// the queries by connection index.
+func (k Keeper) getNextQueryConnection(ctx sdk.Context, start []byte) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueryByConnectionKey)
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	// the keys consist of the length prefixed connection id and the query id
+	key := iterator.Key()
+	return string(key[1 : 1+int(key[0])]), true
+}
+
+// connectionStatusUpdate is the state of the suspension or the resumption of the queries of a connection which
+// is handled across several blocks.
+type connectionStatusUpdate struct {
+	suspend     bool
+	lastQueryID uint64
+}
+
+// checkConnectionClient suspends the queries registered over the connection if its client isn't active, or
+// resumes them if the client is active again. At most limit queries are handled, it returns the amount of the
+// handled queries (one at least, the status check itself) and whether all the queries of the connection have been
+// handled. Once they are, the connection is marked with its new status, so the query owners are notified only once
+// per change of the client status. The errors are logged, they must not stop the chain.
+func (k Keeper) checkConnectionClient(ctx sdk.Context, connectionID string, limit uint64) (uint64, bool) {
+	connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !ok {
+		k.Logger(ctx).Error("CheckQueryConnectionClients: failed to get connection", "connection_id", connectionID)
+		return 1, true
+	}
+
+	clientState, err := k.GetClientState(ctx, connection.ClientId)
+	if err != nil {
+		k.Logger(ctx).Error("CheckQueryConnectionClients: failed to get client state",
+			"connection_id", connectionID, "client_id", connection.ClientId, "error", err)
+		return 1, true
+	}
+
+	status := k.getClientStatus(ctx, connection.ClientId, clientState)
+	suspend := status != exported.Active
+
+	update, inProgress := k.getConnectionStatusUpdate(ctx, connectionID)
+	if !inProgress || update.suspend != suspend {
+		if !inProgress && k.isConnectionSuspended(ctx, connectionID) == suspend {
+			return 1, true
+		}
+		// the update starts over from the first query if the client status has changed while it's in progress
+		update = connectionStatusUpdate{suspend: suspend}
+	}
+
+	queryIDs, left := k.getConnectionQueryIDs(ctx, connectionID, update.lastQueryID, limit)
+	for _, queryID := range queryIDs {
+		k.updateQueryStatus(ctx, queryID, connection.ClientId, status)
+	}
+
+	if left {
+		update.lastQueryID = queryIDs[len(queryIDs)-1]
+		k.setConnectionStatusUpdate(ctx, connectionID, update)
+		return uint64(len(queryIDs)), false
+	}
+
+	k.removeConnectionStatusUpdate(ctx, connectionID)
+	k.setConnectionSuspended(ctx, connectionID, suspend)
+
+	if len(queryIDs) == 0 {
+		return 1, true
+	}
+	return uint64(len(queryIDs)), true
+}
+
+// getConnectionQueryIDs returns the ids of at most limit queries registered over the connection starting right
+// after the query with afterID, and whether there are some queries of the connection left.
+func (k Keeper) getConnectionQueryIDs(ctx sdk.Context, connectionID string, afterID uint64, limit uint64) ([]uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueryByConnectionKeyPrefix(connectionID))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(afterID+1), nil)
+	defer iterator.Close()
+
+	var queryIDs []uint64
+	for ; iterator.Valid() && uint64(len(queryIDs)) < limit; iterator.Next() {
+		queryIDs = append(queryIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return queryIDs, iterator.Valid()
+}
+
+// updateQueryStatus suspends the query if the client status isn't active or resumes it if the status is active,
+// unless the query is in that state already.
+func (k Keeper) updateQueryStatus(ctx sdk.Context, queryID uint64, clientID string, status exported.Status) {
+	query, err := k.GetQueryByID(ctx, queryID)
+	if err != nil {
+		k.Logger(ctx).Error("CheckQueryConnectionClients: failed to get query", "query_id", queryID, "error", err)
+		return
+	}
+
+	switch {
+	case status == exported.Active && query.Suspended:
+		if err := k.resumeQuery(ctx, query, clientID); err != nil {
+			k.Logger(ctx).Error("CheckQueryConnectionClients: failed to resume query", "query_id", query.Id, "error", err)
+		}
+	case status != exported.Active && !query.Suspended:
+		if err := k.suspendQuery(ctx, query, clientID, status); err != nil {
+			k.Logger(ctx).Error("CheckQueryConnectionClients: failed to suspend query", "query_id", query.Id, "error", err)
+		}
+	}
+}
+
+// suspendQuery marks the query as suspended and lets the query owner contract know about it.
+func (k Keeper) suspendQuery(ctx sdk.Context, query *types.RegisteredQuery, clientID string, status exported.Status) error {
+	query.Suspended = true
+	if err := k.SaveQuery(ctx, *query); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuerySuspended),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		sdk.NewAttribute(types.AttributeKeyClientStatus, status.String()),
+	))
+	k.Logger(ctx).Debug("Suspended query", "query_id", query.Id, "client_id", clientID, "client_status", status)
+
+	owner, err := query.GetOwnerAddress()
+	if err != nil {
+		return err
+	}
+
+	msg, err := sudo.NewQuerySuspendedMessage(query.Id, clientID, status.String())
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInternal, "failed to build sudo message: %v", err)
+	}
+
+	k.callSudo(ctx, owner, query.Id, msg, k.sudoPayload(owner, msg))
+
+	return nil
+}
+
+// resumeQuery marks the suspended query as active again and lets the query owner contract know about it.
+func (k Keeper) resumeQuery(ctx sdk.Context, query *types.RegisteredQuery, clientID string) error {
+	query.Suspended = false
+	query.ResumedAtHeight = uint64(ctx.BlockHeight())
+	if err := k.SaveQuery(ctx, *query); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeNeutronMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryResumed),
+		sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+	))
+	k.Logger(ctx).Debug("Resumed query", "query_id", query.Id, "client_id", clientID)
+
+	owner, err := query.GetOwnerAddress()
+	if err != nil {
+		return err
+	}
+
+	msg, err := sudo.NewQueryResumedMessage(query.Id, clientID)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInternal, "failed to build sudo message: %v", err)
+	}
+
+	k.callSudo(ctx, owner, query.Id, msg, k.sudoPayload(owner, msg))
+
+	return nil
+}
+
+// checkConnectionClientActive returns an error if the IBC client of the connection isn't active, so the results
+// of the queries registered over the connection can't be submitted.
+func (k Keeper) checkConnectionClientActive(ctx sdk.Context, connectionID string) error {
+	connection, ok := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s'", connectionID)
+	}
+
+	clientState, err := k.GetClientState(ctx, connection.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if status := k.getClientStatus(ctx, connection.ClientId, clientState); status != exported.Active {
+		return sdkerrors.Wrapf(types.ErrClientNotActive, "client %s of connection %s is %s", connection.ClientId, connectionID, status)
+	}
+
+	return nil
+}
+
+func (k Keeper) getClientStatus(ctx sdk.Context, clientID string, clientState exported.ClientState) exported.Status {
+	return clientState.Status(ctx, k.ibcKeeper.ClientKeeper.ClientStore(ctx, clientID), k.cdc)
+}
+
+// RebuildSuspendedConnections derives the suspended connections markers from the Suspended flags of the registered
+// queries, since the markers aren't a part of the genesis state. A connection with all of its queries suspended is
+// marked as suspended. A connection with only some of them suspended has its queries suspended from the first one
+// on, and the next status check of its client resumes them all over again if the client is active.
+// The LastStatusCheckedConnection cursor isn't exported either, the status checks start from the first connection.
+func (k Keeper) RebuildSuspendedConnections(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefixKey := range [][]byte{types.SuspendedConnectionKey, types.ConnectionStatusUpdateKey} {
+		iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	k.setLastStatusCheckedConnection(ctx, "")
+
+	type queriesCount struct {
+		total     int
+		suspended int
+	}
+	counts := make(map[string]*queriesCount)
+	var connections []string
+	k.IterateRegisteredQueries(ctx, func(_ int64, query types.RegisteredQuery) (stop bool) {
+		count, ok := counts[query.ConnectionId]
+		if !ok {
+			count = &queriesCount{}
+			counts[query.ConnectionId] = count
+			connections = append(connections, query.ConnectionId)
+		}
+		count.total++
+		if query.Suspended {
+			count.suspended++
+		}
+		return false
+	})
+
+	for _, connectionID := range connections {
+		switch count := counts[connectionID]; {
+		case count.suspended == 0:
+		case count.suspended == count.total:
+			k.setConnectionSuspended(ctx, connectionID, true)
+		default:
+			k.setConnectionStatusUpdate(ctx, connectionID, connectionStatusUpdate{suspend: true})
+		}
+	}
+}
+
+// isConnectionSuspended returns whether the queries of the connection are suspended or are being suspended.
+func (k Keeper) isConnectionSuspended(ctx sdk.Context, connectionID string) bool {
+	if update, ok := k.getConnectionStatusUpdate(ctx, connectionID); ok {
+		return update.suspend
+	}
+	return ctx.KVStore(k.storeKey).Has(types.GetSuspendedConnectionKey(connectionID))
+}
+
+func (k Keeper) setConnectionSuspended(ctx sdk.Context, connectionID string, suspended bool) {
+	store := ctx.KVStore(k.storeKey)
+	if suspended {
+		store.Set(types.GetSuspendedConnectionKey(connectionID), []byte{})
+	} else {
+		store.Delete(types.GetSuspendedConnectionKey(connectionID))
+	}
+}
+
+func (k Keeper) getConnectionStatusUpdate(ctx sdk.Context, connectionID string) (connectionStatusUpdate, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetConnectionStatusUpdateKey(connectionID))
+	if bz == nil {
+		return connectionStatusUpdate{}, false
+	}
+	return connectionStatusUpdate{suspend: bz[0] == 1, lastQueryID: sdk.BigEndianToUint64(bz[1:])}, true
+}
+
+func (k Keeper) setConnectionStatusUpdate(ctx sdk.Context, connectionID string, update connectionStatusUpdate) {
+	bz := []byte{0}
+	if update.suspend {
+		bz[0] = 1
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetConnectionStatusUpdateKey(connectionID), append(bz, sdk.Uint64ToBigEndian(update.lastQueryID)...))
+}
+
+func (k Keeper) removeConnectionStatusUpdate(ctx sdk.Context, connectionID string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetConnectionStatusUpdateKey(connectionID))
+}
+
+func (k Keeper) getLastStatusCheckedConnection(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.LastStatusCheckedConnectionKey))
+}
+
+func (k Keeper) setLastStatusCheckedConnection(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	if connectionID == "" {
+		store.Delete(types.LastStatusCheckedConnectionKey)
+		return
+	}
+	store.Set(types.LastStatusCheckedConnectionKey, []byte(connectionID))
+}
@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	tendermintLightClientTypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+
+	"github.com/neutron-org/neutron/internal/sudo"
+	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckQueryConnectionClients() {
+	suite.SetupTest()
+
+	// the connection the suspended query is moved to
+	newPath := testutil.NewICAPath(suite.ChainA, suite.ChainB)
+	suite.Coordinator.SetupConnections(newPath)
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		app           = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper      = app.InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+		clientID      = suite.Path.EndpointA.ClientID
+		kvKey         = &iqtypes.KVKey{Path: host.StoreKey, Key: host.FullClientStateKey(suite.Path.EndpointB.ClientID)}
+	)
+
+	codeId := suite.StoreReflectCode(ctx, contractOwner, reflectContractPath)
+	owner := suite.InstantiateReflectContract(ctx, contractOwner, codeId)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, owner)
+
+	register := func(connectionID string) (uint64, error) {
+		res, err := msgSrv.RegisterInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgRegisterInterchainQuery{
+			ConnectionId: connectionID,
+			Keys:         []*iqtypes.KVKey{kvKey},
+			QueryType:    string(iqtypes.InterchainQueryTypeKV),
+			UpdatePeriod: 1,
+			Sender:       owner.String(),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.Id, nil
+	}
+
+	queryID, err := register(suite.Path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	resumedQueryID, err := register(suite.Path.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+	suite.TopUpWallet(ctx, senderAddress, owner)
+	otherQueryID, err := register(newPath.EndpointA.ConnectionID)
+	suite.Require().NoError(err)
+
+	// nothing is suspended while the clients are active
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	query, err := iqkeeper.GetQueryByID(ctx, queryID)
+	suite.Require().NoError(err)
+	suite.Require().False(query.Suspended)
+
+	clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+	tmClientState := clientState.(*tendermintLightClientTypes.ClientState)
+	tmClientState.FrozenHeight = ibcclienttypes.NewHeight(0, 1)
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tmClientState)
+
+	// the query over the frozen client is suspended and its owner is notified
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueQuerySuspended))
+
+	query, err = iqkeeper.GetQueryByID(ctx, queryID)
+	suite.Require().NoError(err)
+	suite.Require().True(query.Suspended)
+
+	otherQuery, err := iqkeeper.GetQueryByID(ctx, otherQueryID)
+	suite.Require().NoError(err)
+	suite.Require().False(otherQuery.Suspended)
+
+	// the reflect contract doesn't handle the sudo message, so the failure is recorded along with the payload
+	suite.Require().Equal(uint64(2), iqkeeper.GetLastSudoFailureID(ctx))
+	failure, err := iqkeeper.GetSudoFailure(ctx, queryID, 1)
+	suite.Require().NoError(err)
+	expectedPayload, err := sudo.NewQuerySuspendedMessage(queryID, clientID, "Frozen")
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedPayload, failure.Payload)
+
+	// the owner is notified only once
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal(uint64(2), iqkeeper.GetLastSudoFailureID(ctx))
+
+	dueQueries, err := iqkeeper.QueriesDueForUpdate(sdktypes.WrapSDKContext(ctx), &iqtypes.QueryQueriesDueForUpdateRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(dueQueries.RegisteredQueries, 1)
+	suite.Require().Equal(otherQueryID, dueQueries.RegisteredQueries[0].Id)
+
+	_, err = msgSrv.SubmitQueryResult(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgSubmitQueryResult{
+		QueryId:  queryID,
+		Sender:   senderAddress.String(),
+		ClientId: clientID,
+		Result:   &iqtypes.QueryResult{KvResults: []*iqtypes.StorageValue{{Key: kvKey.Key, StoragePrefix: kvKey.Path}}},
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrQuerySuspended)
+
+	// no new queries can be registered over the connection of the frozen client
+	_, err = register(suite.Path.EndpointA.ConnectionID)
+	suite.Require().ErrorIs(err, iqtypes.ErrClientNotActive)
+
+	// the query is resumed by moving it to a connection with an active client
+	_, err = msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId:         queryID,
+		NewConnectionId: newPath.EndpointA.ConnectionID,
+		Sender:          owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	query, err = iqkeeper.GetQueryByID(ctx, queryID)
+	suite.Require().NoError(err)
+	suite.Require().False(query.Suspended)
+
+	// the query left over the connection is resumed once the client is active again
+	tmClientState.FrozenHeight = ibcclienttypes.ZeroHeight()
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tmClientState)
+
+	// the query moved to the connection before it's checked again is suspended along with the connection queries
+	_, err = msgSrv.UpdateInterchainQuery(sdktypes.WrapSDKContext(ctx), &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId:         otherQueryID,
+		NewConnectionId: suite.Path.EndpointA.ConnectionID,
+		Sender:          owner.String(),
+	})
+	suite.Require().NoError(err)
+
+	otherQuery, err = iqkeeper.GetQueryByID(ctx, otherQueryID)
+	suite.Require().NoError(err)
+	suite.Require().True(otherQuery.Suspended)
+
+	ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().True(hasAction(ctx, iqtypes.AttributeValueQueryResumed))
+
+	query, err = iqkeeper.GetQueryByID(ctx, resumedQueryID)
+	suite.Require().NoError(err)
+	suite.Require().False(query.Suspended)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), query.ResumedAtHeight)
+
+	otherQuery, err = iqkeeper.GetQueryByID(ctx, otherQueryID)
+	suite.Require().NoError(err)
+	suite.Require().False(otherQuery.Suspended)
+
+	suite.Require().Equal(uint64(4), iqkeeper.GetLastSudoFailureID(ctx))
+	failure, err = iqkeeper.GetSudoFailure(ctx, resumedQueryID, 3)
+	suite.Require().NoError(err)
+	expectedPayload, err = sudo.NewQueryResumedMessage(resumedQueryID, clientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedPayload, failure.Payload)
+
+	// the owner is notified only once
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal(uint64(4), iqkeeper.GetLastSudoFailureID(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCheckQueryConnectionClientsLimit() {
+	suite.SetupTest()
+
+	newPath := testutil.NewICAPath(suite.ChainA, suite.ChainB)
+	suite.Coordinator.SetupConnections(newPath)
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper = app.InterchainQueriesKeeper
+		owner    = wasmKeeper.RandomAccountAddress(suite.T()).String()
+	)
+
+	params := iqkeeper.GetParams(ctx)
+	params.ClientStatusChecksPerBlock = 1
+	iqkeeper.SetParams(ctx, params)
+
+	for i, connectionID := range []string{suite.Path.EndpointA.ConnectionID, newPath.EndpointA.ConnectionID} {
+		suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+			Id:           uint64(i + 1),
+			Owner:        owner,
+			QueryType:    string(iqtypes.InterchainQueryTypeKV),
+			ConnectionId: connectionID,
+		}))
+	}
+
+	freeze := func(clientID string) {
+		clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+		suite.Require().True(found)
+		tmClientState := clientState.(*tendermintLightClientTypes.ClientState)
+		tmClientState.FrozenHeight = ibcclienttypes.NewHeight(0, 1)
+		app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tmClientState)
+	}
+	suspended := func(queryID uint64) bool {
+		query, err := iqkeeper.GetQueryByID(ctx, queryID)
+		suite.Require().NoError(err)
+		return query.Suspended
+	}
+
+	freeze(suite.Path.EndpointA.ClientID)
+	freeze(newPath.EndpointA.ClientID)
+
+	// a single connection is checked per block, starting right after the last checked one
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().True(suspended(1))
+	suite.Require().False(suspended(2))
+
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().True(suspended(2))
+
+	// a connection that can't be checked is skipped
+	suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+		Id:           3,
+		Owner:        owner,
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		ConnectionId: "connection-unknown",
+	}))
+	suite.Require().NotPanics(func() {
+		for i := 0; i < 3; i++ {
+			iqkeeper.CheckQueryConnectionClients(ctx)
+		}
+	})
+	suite.Require().False(suspended(3))
+}
+
+func (suite *KeeperTestSuite) TestCheckQueryConnectionClientsQueriesLimit() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper = app.InterchainQueriesKeeper
+		owner    = wasmKeeper.RandomAccountAddress(suite.T()).String()
+		clientID = suite.Path.EndpointA.ClientID
+	)
+
+	params := iqkeeper.GetParams(ctx)
+	params.ClientStatusChecksPerBlock = 2
+	iqkeeper.SetParams(ctx, params)
+
+	for id := uint64(1); id <= 3; id++ {
+		suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+			Id:           id,
+			Owner:        owner,
+			QueryType:    string(iqtypes.InterchainQueryTypeKV),
+			ConnectionId: suite.Path.EndpointA.ConnectionID,
+		}))
+	}
+
+	clientState, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+	tmClientState := clientState.(*tendermintLightClientTypes.ClientState)
+	setFrozen := func(frozen bool) {
+		tmClientState.FrozenHeight = ibcclienttypes.ZeroHeight()
+		if frozen {
+			tmClientState.FrozenHeight = ibcclienttypes.NewHeight(0, 1)
+		}
+		app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tmClientState)
+	}
+	suspended := func() []bool {
+		var flags []bool
+		for id := uint64(1); id <= 3; id++ {
+			query, err := iqkeeper.GetQueryByID(ctx, id)
+			suite.Require().NoError(err)
+			flags = append(flags, query.Suspended)
+		}
+		return flags
+	}
+
+	// the queries of the connection are suspended across several blocks
+	setFrozen(true)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{true, true, false}, suspended())
+
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{true, true, true}, suspended())
+
+	// nothing is done until the client status changes
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{true, true, true}, suspended())
+
+	// the client is frozen again while the queries are being resumed, they are suspended starting from the first one
+	setFrozen(false)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{false, false, true}, suspended())
+
+	setFrozen(true)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{true, true, true}, suspended())
+
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	setFrozen(false)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{false, false, false}, suspended())
+}
+
+func (suite *KeeperTestSuite) TestRebuildSuspendedConnections() {
+	suite.SetupTest()
+
+	var (
+		ctx      = suite.ChainA.GetContext()
+		iqkeeper = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		owner    = wasmKeeper.RandomAccountAddress(suite.T()).String()
+	)
+
+	save := func(suspended ...bool) {
+		for i, flag := range suspended {
+			suite.Require().NoError(iqkeeper.SaveQuery(ctx, iqtypes.RegisteredQuery{
+				Id:           uint64(i + 1),
+				Owner:        owner,
+				QueryType:    string(iqtypes.InterchainQueryTypeKV),
+				ConnectionId: suite.Path.EndpointA.ConnectionID,
+				Suspended:    flag,
+			}))
+		}
+		iqkeeper.RebuildSuspendedConnections(ctx)
+	}
+	suspended := func() []bool {
+		var flags []bool
+		for id := uint64(1); id <= 2; id++ {
+			query, err := iqkeeper.GetQueryByID(ctx, id)
+			suite.Require().NoError(err)
+			flags = append(flags, query.Suspended)
+		}
+		return flags
+	}
+
+	// the imported suspended queries are resumed since the client is active
+	save(true, true)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{false, false}, suspended())
+
+	// so are the queries of a connection being suspended or resumed at the moment of the export
+	save(false, true)
+	iqkeeper.CheckQueryConnectionClients(ctx)
+	suite.Require().Equal([]bool{false, false}, suspended())
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// MarkTxQueryToRemove schedules removal of the processed transactions of the TX query with the given id,
+// either removed or moved to another connection. The processed transactions are removed in batches by
+// RemoveProcessedTransactions.
+func (k Keeper) MarkTxQueryToRemove(ctx sdk.Context, queryID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTxQueryToRemoveByIDKey(queryID), []byte{})
+}
+
+// IsTxQueryToRemove checks whether the processed transactions of the TX query with the given id
+// are scheduled for removal.
+func (k Keeper) IsTxQueryToRemove(ctx sdk.Context, queryID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTxQueryToRemoveByIDKey(queryID))
+}
+
+// RemoveProcessedTransactions removes at most Params.TxQueryRemovalLimit processed transactions of
+// the TX queries scheduled for removal. A TX query stays scheduled for removal until all of its processed
+// transactions are removed.
+func (k Keeper) RemoveProcessedTransactions(ctx sdk.Context) {
+	limit := k.GetParams(ctx).TxQueryRemovalLimit
+
+	var (
+		removed      uint64
+		cleanedUpIDs []uint64
+		start        []byte
+	)
+	for removed < limit {
+		queryID, ok := k.getNextTxQueryToRemove(ctx, start)
+		if !ok {
+			break
+		}
+
+		var left bool
+		removed, left = k.removeProcessedTransactionsForQuery(ctx, queryID, removed, limit)
+		if left {
+			break
+		}
+		cleanedUpIDs = append(cleanedUpIDs, queryID)
+
+		if queryID == math.MaxUint64 {
+			break
+		}
+		start = sdk.Uint64ToBigEndian(queryID + 1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, queryID := range cleanedUpIDs {
+		store.Delete(types.GetTxQueryToRemoveByIDKey(queryID))
+	}
+
+	if removed > 0 {
+		k.Logger(ctx).Debug("Removed processed transactions of removed TX queries",
+			"removed", removed, "cleaned_up_queries", len(cleanedUpIDs))
+	}
+}
+
+// getNextTxQueryToRemove returns the id of the first TX query scheduled for removal starting from the
+// start key. The queries are read one by one, so no more of them are loaded than the removal limit allows.
+func (k Keeper) getNextTxQueryToRemove(ctx sdk.Context, start []byte) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TxQueryToRemoveKey)
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(iterator.Key()), true
+}
+
+// removeProcessedTransactionsForQuery removes the processed transactions of the query until the total
+// amount of removed transactions reaches the limit. It returns the new total amount of removed transactions
+// and whether there are some processed transactions of the query left.
+func (k Keeper) removeProcessedTransactionsForQuery(ctx sdk.Context, queryID uint64, removed uint64, limit uint64) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSubmittedTransactionIDForQueryKeyPrefix(queryID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if removed+uint64(len(keys)) >= limit {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	left := iterator.Valid()
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return removed + uint64(len(keys)), left
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/neutron-org/neutron/testutil/interchainqueries/keeper"
+)
+
+func TestRemoveProcessedTransactions(t *testing.T) {
+	k, ctx := testkeeper.InterchainQueriesKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.TxQueryRemovalLimit = 4
+	k.SetParams(ctx, params)
+
+	txsCount := map[uint64]int{1: 5, 2: 3, 3: 2}
+	for queryID, count := range txsCount {
+		for i := 0; i < count; i++ {
+			k.SaveTransactionAsProcessed(ctx, queryID, []byte(fmt.Sprintf("tx hash %d", i)))
+		}
+	}
+	processedTxs := func(queryID uint64) int {
+		var count int
+		for i := 0; i < txsCount[queryID]; i++ {
+			if k.CheckTransactionIsAlreadyProcessed(ctx, queryID, []byte(fmt.Sprintf("tx hash %d", i))) {
+				count++
+			}
+		}
+		return count
+	}
+
+	// query 3 is still registered, so its processed transactions must be kept
+	k.MarkTxQueryToRemove(ctx, 1)
+	k.MarkTxQueryToRemove(ctx, 2)
+
+	k.RemoveProcessedTransactions(ctx)
+	require.Equal(t, 1, processedTxs(1))
+	require.Equal(t, 3, processedTxs(2))
+	require.True(t, k.IsTxQueryToRemove(ctx, 1))
+	require.True(t, k.IsTxQueryToRemove(ctx, 2))
+
+	k.RemoveProcessedTransactions(ctx)
+	require.Equal(t, 0, processedTxs(1))
+	require.Equal(t, 0, processedTxs(2))
+	require.False(t, k.IsTxQueryToRemove(ctx, 1))
+	require.False(t, k.IsTxQueryToRemove(ctx, 2))
+
+	require.Equal(t, 2, processedTxs(3))
+	require.False(t, k.IsTxQueryToRemove(ctx, 3))
+}
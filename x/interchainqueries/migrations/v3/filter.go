@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// quotes are the runes tendermint search doesn't allow in string values. There is no way to escape them.
+const quotes = `'"`
+
+// legacyFilterCondition is a condition of a transactions filter of v2. The filters of v2 are flat lists of conditions.
+type legacyFilterCondition struct {
+	Field string      `json:"field"`
+	Op    string      `json:"op"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// migrateTransactionsFilter rewrites the conditions of the filter which compare a field with a string value containing
+// quotes, since such values can't be rendered to a tendermint search. An equality condition is replaced with the contains
+// conditions on every non-blank part of the value between the quotes. The other conditions and the values with no such
+// parts are replaced with the exists condition on the field, so the migrated filter matches all the transactions the
+// original one is meant to match. The second return value is false if the filter doesn't need to be migrated or can't
+// be decoded, the filter is kept as is in that case.
+func migrateTransactionsFilter(filter string) (string, bool) {
+	decoder := json.NewDecoder(strings.NewReader(filter))
+	// keep the numbers as they are written in the filter
+	decoder.UseNumber()
+
+	var conditions []legacyFilterCondition
+	if err := decoder.Decode(&conditions); err != nil {
+		return "", false
+	}
+
+	migrated := make([]legacyFilterCondition, 0, len(conditions))
+	changed := false
+	for _, condition := range conditions {
+		value, ok := condition.Value.(string)
+		if !ok || !strings.ContainsAny(value, quotes) {
+			migrated = append(migrated, condition)
+			continue
+		}
+		changed = true
+
+		var parts []string
+		switch strings.ToLower(condition.Op) {
+		case "eq", "contains":
+			parts = strings.FieldsFunc(value, func(r rune) bool { return strings.ContainsRune(quotes, r) })
+		}
+		contains := make([]legacyFilterCondition, 0, len(parts))
+		for _, part := range parts {
+			if strings.TrimSpace(part) != "" {
+				contains = append(contains, legacyFilterCondition{Field: condition.Field, Op: "contains", Value: part})
+			}
+		}
+		if len(contains) == 0 {
+			migrated = append(migrated, legacyFilterCondition{Field: condition.Field, Op: "exists"})
+			continue
+		}
+		migrated = append(migrated, contains...)
+	}
+	if !changed {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	// the values are rendered to tendermint searches as they are, don't turn <, > and & into unicode escapes
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(migrated); err != nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
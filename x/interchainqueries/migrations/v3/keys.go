@@ -0,0 +1,49 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// The store keys of the module at version 3. They are kept here, so the migration doesn't change along with
+// the keys of the later versions.
+const (
+	prefixRegisteredQuery       = 0x01
+	prefixRegisteredQueryResult = 0x02
+	prefixSubmittedTx           = 0x03
+	prefixTxQueryToRemove       = 0x04
+	prefixOwnerQueriesCount     = 0x07
+	prefixQueryByOwner          = 0x08
+	prefixQueryByConnection     = 0x09
+)
+
+var (
+	registeredQueryKey = []byte{prefixRegisteredQuery}
+	submittedTxKey     = []byte{prefixSubmittedTx}
+)
+
+func getRegisteredQueryByIDKey(id uint64) []byte {
+	return append([]byte{prefixRegisteredQuery}, sdk.Uint64ToBigEndian(id)...)
+}
+
+func getRegisteredQueryResultByIDKey(id uint64) []byte {
+	return append([]byte{prefixRegisteredQueryResult}, sdk.Uint64ToBigEndian(id)...)
+}
+
+func getTxQueryToRemoveByIDKey(queryID uint64) []byte {
+	return append([]byte{prefixTxQueryToRemove}, sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func getOwnerQueriesCountKey(owner string) []byte {
+	return append([]byte{prefixOwnerQueriesCount}, []byte(owner)...)
+}
+
+func getQueryByOwnerKey(owner string, queryID uint64) []byte {
+	key := append([]byte{prefixQueryByOwner}, address.MustLengthPrefix([]byte(owner))...)
+	return append(key, sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func getQueryByConnectionKey(connectionID string, queryID uint64) []byte {
+	key := append([]byte{prefixQueryByConnection}, address.MustLengthPrefix([]byte(connectionID))...)
+	return append(key, sdk.Uint64ToBigEndian(queryID)...)
+}
@@ -0,0 +1,37 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// The params of the module at version 3 along with their initial values. They are kept here, so the migration
+// doesn't change along with the default params of the later versions.
+var params = []struct {
+	key   []byte
+	value interface{}
+}{
+	{[]byte("QuerySubmitTimeout"), uint64(518400)},
+	{[]byte("QueryDeposit"), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)))},
+	{[]byte("QueryExpiryPeriod"), uint64(1_555_200)},
+	{[]byte("BurnExpiredQueryDeposit"), false},
+	{[]byte("ExpiryChecksPerBlock"), uint64(100)},
+	{[]byte("TxQueryRemovalLimit"), uint64(10_000)},
+	{[]byte("MaxResultHistorySize"), uint64(100)},
+	{[]byte("SudoCallGasLimit"), uint64(1_000_000)},
+	{[]byte("MaxKvQueryKeysCount"), uint64(32)},
+	{[]byte("MaxActiveQueriesPerOwner"), uint64(100)},
+	{[]byte("MaxTransactionsFilterLength"), uint64(4096)},
+	{[]byte("QueryDepositPerKey"), sdk.Coins(nil)},
+	{[]byte("TxQueryDepositSurcharge"), sdk.Coins(nil)},
+	{[]byte("ClientStatusChecksPerBlock"), uint64(100)},
+}
+
+// migrateParams sets the params which are not present in the store to their initial values.
+func migrateParams(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	for _, param := range params {
+		if !paramstore.Has(ctx, param.key) {
+			paramstore.Set(ctx, param.key, param.value)
+		}
+	}
+}
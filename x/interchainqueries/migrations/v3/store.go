@@ -0,0 +1,302 @@
+package v3
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// Field numbers of the registered query, query result and height fields the migration works with.
+const (
+	queryIDField                 protowire.Number = 1
+	queryOwnerField              protowire.Number = 2
+	queryTransactionsFilterField protowire.Number = 5
+	queryConnectionIDField       protowire.Number = 6
+	queryLegacyRemoteHeightField protowire.Number = 9
+	queryRegisteredAtHeightField protowire.Number = 13
+	queryRemoteHeightField       protowire.Number = 19
+
+	resultRevisionField protowire.Number = 4
+
+	heightRevisionNumberField protowire.Number = 1
+	heightRevisionHeightField protowire.Number = 2
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The migration includes:
+//
+// - Setting the params which are not present in the store to their initial values.
+// - Setting the registration height of the registered queries to the current block height,
+// so the queries don't get expired right after the migration.
+// - Moving the last submitted result remote height of the registered queries to the revision aware
+// height. The revision is the revision of the latest height of the IBC client of the query connection.
+// - Setting the same revision to the stored KV query results which were saved without it.
+// - Rewriting the conditions of the TX query filters which compare a field with a value containing quotes, since such
+// filters can't be rendered to a tendermint search and aren't valid anymore.
+// - Counting the registered queries of each owner for the active queries per owner limit.
+// - Building the indexes of the registered queries by owner and by connection.
+// - Scheduling removal of the processed transactions left by the TX queries removed in the past.
+//
+// The store is migrated in the wire format, so the migration keeps working the same way whatever changes
+// are made to the messages in the later versions of the module.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace, ibcKeeper *ibckeeper.Keeper) error {
+	migrateParams(ctx, paramstore)
+
+	if err := migrateRegisteredQueries(ctx, storeKey, ibcKeeper); err != nil {
+		return err
+	}
+
+	migrateProcessedTransactions(ctx, storeKey)
+
+	return nil
+}
+
+// field is a field of a protobuf message in the wire format.
+type field struct {
+	number protowire.Number
+	// varint is the value of a varint field.
+	varint uint64
+	// bytes is the payload of a length-delimited field.
+	bytes []byte
+	// raw is the whole encoded field including its tag.
+	raw []byte
+}
+
+// decodeFields returns the fields of the protobuf message encoded in bz in the order they are encoded.
+func decodeFields(bz []byte) ([]field, error) {
+	var fields []field
+	for len(bz) > 0 {
+		number, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to decode field tag: %v", protowire.ParseError(n))
+		}
+
+		m := protowire.ConsumeFieldValue(number, typ, bz[n:])
+		if m < 0 {
+			return nil, sdkerrors.Wrapf(types.ErrProtoUnmarshal, "failed to decode field %d: %v", number, protowire.ParseError(m))
+		}
+
+		f := field{number: number, raw: bz[:n+m]}
+		switch typ {
+		case protowire.VarintType:
+			f.varint, _ = protowire.ConsumeVarint(bz[n:])
+		case protowire.BytesType:
+			f.bytes, _ = protowire.ConsumeBytes(bz[n:])
+		}
+		fields = append(fields, f)
+
+		bz = bz[n+m:]
+	}
+
+	return fields, nil
+}
+
+func appendVarint(bz []byte, number protowire.Number, value uint64) []byte {
+	bz = protowire.AppendTag(bz, number, protowire.VarintType)
+	return protowire.AppendVarint(bz, value)
+}
+
+// legacyQuery keeps the fields of a registered query the migration works with. The rest of its fields are
+// kept as is.
+type legacyQuery struct {
+	id                 uint64
+	owner              string
+	connectionID       string
+	registeredAtHeight uint64
+	remoteHeight       uint64
+	transactionsFilter string
+	fields             []field
+}
+
+func decodeLegacyQuery(bz []byte) (legacyQuery, error) {
+	fields, err := decodeFields(append([]byte{}, bz...))
+	if err != nil {
+		return legacyQuery{}, err
+	}
+
+	query := legacyQuery{fields: fields}
+	for _, f := range fields {
+		switch f.number {
+		case queryIDField:
+			query.id = f.varint
+		case queryOwnerField:
+			query.owner = string(f.bytes)
+		case queryConnectionIDField:
+			query.connectionID = string(f.bytes)
+		case queryTransactionsFilterField:
+			query.transactionsFilter = string(f.bytes)
+		case queryRegisteredAtHeightField:
+			query.registeredAtHeight = f.varint
+		case queryLegacyRemoteHeightField:
+			query.remoteHeight = f.varint
+		}
+	}
+
+	return query, nil
+}
+
+// encode encodes the query with the remote height moved to the revision aware height at the revision,
+// the registration height set to the height if the query has been registered with no registration height
+// and the transactions filter migrated if it has values with quotes.
+func (q legacyQuery) encode(revision uint64, height uint64) []byte {
+	filter, filterMigrated := migrateTransactionsFilter(q.transactionsFilter)
+
+	var bz []byte
+	for _, f := range q.fields {
+		switch {
+		case f.number == queryLegacyRemoteHeightField:
+			continue
+		case f.number == queryTransactionsFilterField && filterMigrated:
+			bz = protowire.AppendTag(bz, queryTransactionsFilterField, protowire.BytesType)
+			bz = protowire.AppendString(bz, filter)
+			continue
+		}
+		bz = append(bz, f.raw...)
+	}
+
+	if q.registeredAtHeight == 0 {
+		bz = appendVarint(bz, queryRegisteredAtHeightField, height)
+	}
+
+	if q.remoteHeight != 0 {
+		var remoteHeight []byte
+		if revision != 0 {
+			remoteHeight = appendVarint(remoteHeight, heightRevisionNumberField, revision)
+		}
+		remoteHeight = appendVarint(remoteHeight, heightRevisionHeightField, q.remoteHeight)
+
+		bz = protowire.AppendTag(bz, queryRemoteHeightField, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, remoteHeight)
+	}
+
+	return bz
+}
+
+func migrateRegisteredQueries(ctx sdk.Context, storeKey storetypes.StoreKey, ibcKeeper *ibckeeper.Keeper) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), registeredQueryKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	var queries []legacyQuery
+	for ; iterator.Valid(); iterator.Next() {
+		query, err := decodeLegacyQuery(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		queries = append(queries, query)
+	}
+	iterator.Close()
+
+	var (
+		owners      []string
+		ownerCounts = make(map[string]uint64)
+	)
+	for _, query := range queries {
+		if _, ok := ownerCounts[query.owner]; !ok {
+			owners = append(owners, query.owner)
+		}
+		ownerCounts[query.owner]++
+
+		revision := connectionRevision(ctx, ibcKeeper, query.connectionID)
+		store.Set(sdk.Uint64ToBigEndian(query.id), query.encode(revision, uint64(ctx.BlockHeight())))
+
+		if err := migrateQueryResult(ctx, storeKey, query.id, revision); err != nil {
+			return err
+		}
+	}
+
+	for _, owner := range owners {
+		ctx.KVStore(storeKey).Set(getOwnerQueriesCountKey(owner), sdk.Uint64ToBigEndian(ownerCounts[owner]))
+	}
+
+	for _, query := range queries {
+		ctx.KVStore(storeKey).Set(getQueryByOwnerKey(query.owner, query.id), []byte{})
+		ctx.KVStore(storeKey).Set(getQueryByConnectionKey(query.connectionID, query.id), []byte{})
+	}
+
+	return nil
+}
+
+// migrateQueryResult sets the revision to the stored result of the query if the result has been saved without it.
+func migrateQueryResult(ctx sdk.Context, storeKey storetypes.StoreKey, queryID uint64, revision uint64) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(getRegisteredQueryResultByIDKey(queryID))
+	if bz == nil || revision == 0 {
+		return nil
+	}
+
+	fields, err := decodeFields(bz)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		if f.number == resultRevisionField {
+			return nil
+		}
+	}
+
+	store.Set(getRegisteredQueryResultByIDKey(queryID), appendVarint(append([]byte{}, bz...), resultRevisionField, revision))
+
+	return nil
+}
+
+// connectionRevision returns the revision of the latest height of the connection client, or zero if there is
+// no such connection or client anymore.
+func connectionRevision(ctx sdk.Context, ibcKeeper *ibckeeper.Keeper, connectionID string) uint64 {
+	connection, ok := ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !ok {
+		return 0
+	}
+
+	clientState, ok := ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !ok {
+		return 0
+	}
+
+	return clientState.GetLatestHeight().GetRevisionNumber()
+}
+
+// migrateProcessedTransactions marks the removed queries which still have processed transactions
+// in the store as TX queries to remove, so their processed transactions are removed in EndBlock.
+func migrateProcessedTransactions(ctx sdk.Context, storeKey storetypes.StoreKey) {
+	store := ctx.KVStore(storeKey)
+	txStore := prefix.NewStore(store, submittedTxKey)
+
+	var (
+		leakedQueryIDs []uint64
+		start          []byte
+	)
+	for {
+		iterator := txStore.Iterator(start, nil)
+		if !iterator.Valid() {
+			iterator.Close()
+			break
+		}
+
+		queryID := sdk.BigEndianToUint64(iterator.Key()[:8])
+		iterator.Close()
+
+		if !store.Has(getRegisteredQueryByIDKey(queryID)) {
+			leakedQueryIDs = append(leakedQueryIDs, queryID)
+		}
+
+		if queryID == math.MaxUint64 {
+			break
+		}
+		// skip the rest of the processed transactions of the query
+		start = sdk.Uint64ToBigEndian(queryID + 1)
+	}
+
+	for _, queryID := range leakedQueryIDs {
+		store.Set(getTxQueryToRemoveByIDKey(queryID), []byte{})
+	}
+}
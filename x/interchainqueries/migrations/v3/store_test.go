@@ -0,0 +1,153 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	tendermintLightClientTypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/testutil"
+	"github.com/neutron-org/neutron/x/interchainqueries/keeper"
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+// the revision of the remote chain the queries are registered over
+const revision = 3
+
+type MigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrationTestSuite))
+}
+
+func (suite *MigrationTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	var (
+		ctx          = suite.ChainA.GetContext()
+		neutronApp   = suite.GetNeutronZoneApp(suite.ChainA)
+		iqkeeper     = neutronApp.InterchainQueriesKeeper
+		cdc          = neutronApp.AppCodec()
+		store        = ctx.KVStore(neutronApp.GetKey(iqtypes.StoreKey))
+		connectionID = suite.Path.EndpointA.ConnectionID
+	)
+
+	// the remote chain has been upgraded to a revision different from the revision of the test chains
+	clientState, found := neutronApp.IBCKeeper.ClientKeeper.GetClientState(ctx, suite.Path.EndpointA.ClientID)
+	suite.Require().True(found)
+	tmClientState := clientState.(*tendermintLightClientTypes.ClientState)
+	tmClientState.LatestHeight = ibcclienttypes.NewHeight(revision, tmClientState.LatestHeight.RevisionHeight)
+	neutronApp.IBCKeeper.ClientKeeper.SetClientState(ctx, suite.Path.EndpointA.ClientID, tmClientState)
+
+	// the queries are stored with the remote height without revision in the field 9, they weren't counted and
+	// indexed before the migration
+	owner := authtypes.NewModuleAddress("owner").String()
+	saveLegacyQuery := func(query iqtypes.RegisteredQuery, remoteHeight uint64) {
+		bz, err := cdc.Marshal(&query)
+		suite.Require().NoError(err)
+		if remoteHeight != 0 {
+			bz = append(bz, proto.EncodeVarint(9<<3|proto.WireVarint)...)
+			bz = append(bz, proto.EncodeVarint(remoteHeight)...)
+		}
+		store.Set(iqtypes.GetRegisteredQueryByIDKey(query.Id), bz)
+	}
+	saveLegacyQuery(iqtypes.RegisteredQuery{
+		Id:           1,
+		Owner:        owner,
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		ConnectionId: connectionID,
+		UpdatePeriod: 5,
+	}, 101)
+	saveLegacyQuery(iqtypes.RegisteredQuery{
+		Id:           3,
+		Owner:        owner,
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		ConnectionId: connectionID,
+	}, 0)
+
+	// the TX query filters with quoted values were accepted before the migration, the other filters are kept as is
+	txOwner := authtypes.NewModuleAddress("tx owner").String()
+	saveLegacyQuery(iqtypes.RegisteredQuery{
+		Id:                 4,
+		Owner:              txOwner,
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		ConnectionId:       connectionID,
+		TransactionsFilter: `[{"field":"tx.height","op":"Gte","value":100},{"field":"wasm.action","op":"Eq","value":"say \"hi\" 'there'"},{"field":"transfer.memo","op":"Lt","value":"a'b"},{"field":"transfer.amount","op":"eq","value":"''"}]`,
+	}, 0)
+	keptFilter := `[ {"field":"transfer.recipient", "op":"Eq", "value":"cosmos1"} ]`
+	saveLegacyQuery(iqtypes.RegisteredQuery{
+		Id:                 5,
+		Owner:              txOwner,
+		QueryType:          string(iqtypes.InterchainQueryTypeTX),
+		ConnectionId:       connectionID,
+		TransactionsFilter: keptFilter,
+	}, 0)
+
+	// the last result is saved without revision
+	bz, err := cdc.Marshal(&iqtypes.QueryResult{Height: 101})
+	suite.Require().NoError(err)
+	store.Set(iqtypes.GetRegisteredQueryResultByIDKey(1), bz)
+
+	// processed transactions of a TX query removed before the migration
+	iqkeeper.SaveTransactionAsProcessed(ctx, 2, []byte("first tx hash"))
+	iqkeeper.SaveTransactionAsProcessed(ctx, 2, []byte("second tx hash"))
+
+	// the params set before the migration are kept, the new ones get their initial values
+	params := iqkeeper.GetParams(ctx)
+	params.QuerySubmitTimeout = 10
+	iqkeeper.SetParams(ctx, params)
+	paramStore := prefix.NewStore(ctx.KVStore(neutronApp.GetKey(paramstypes.StoreKey)), []byte(iqtypes.ModuleName+"/"))
+	for _, key := range [][]byte{iqtypes.KeyQueryExpiryPeriod, iqtypes.KeyClientStatusChecksPerBlock, iqtypes.KeyQueryDepositPerKey} {
+		paramStore.Delete(key)
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(iqkeeper).Migrate2to3(ctx))
+
+	suite.Require().False(iqkeeper.IsTxQueryToRemove(ctx, 1))
+	suite.Require().True(iqkeeper.IsTxQueryToRemove(ctx, 2))
+
+	query, err := iqkeeper.GetQueryByID(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), query.RegisteredAtHeight)
+	suite.Require().Equal(uint64(5), query.UpdatePeriod)
+	suite.Require().Equal(connectionID, query.ConnectionId)
+	suite.Require().Equal(ibcclienttypes.NewHeight(revision, 101), query.LastSubmittedResultRemoteHeight)
+
+	query, err = iqkeeper.GetQueryByID(ctx, 3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ibcclienttypes.ZeroHeight(), query.LastSubmittedResultRemoteHeight)
+
+	result, err := iqkeeper.GetQueryResultByID(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(revision), result.Revision)
+
+	// the results which are not newer than the migrated height are rejected
+	suite.Require().ErrorIs(iqkeeper.UpdateLastRemoteHeight(ctx, 1, ibcclienttypes.NewHeight(revision, 101)), iqtypes.ErrInvalidHeight)
+
+	query, err = iqkeeper.GetQueryByID(ctx, 4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`[{"field":"tx.height","op":"Gte","value":100},{"field":"wasm.action","op":"contains","value":"say "},{"field":"wasm.action","op":"contains","value":"hi"},{"field":"wasm.action","op":"contains","value":"there"},{"field":"transfer.memo","op":"exists"},{"field":"transfer.amount","op":"exists"}]`, query.TransactionsFilter)
+	tendermintQueries, err := query.TendermintQueries()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"tx.height >= 100 AND wasm.action CONTAINS 'say ' AND wasm.action CONTAINS 'hi' AND wasm.action CONTAINS 'there' AND transfer.memo EXISTS AND transfer.amount EXISTS"}, tendermintQueries)
+
+	query, err = iqkeeper.GetQueryByID(ctx, 5)
+	suite.Require().NoError(err)
+	suite.Require().Equal(keptFilter, query.TransactionsFilter)
+
+	suite.Require().Equal(uint64(2), iqkeeper.GetOwnerQueriesCount(ctx, owner))
+	suite.Require().Equal(uint64(2), iqkeeper.GetOwnerQueriesCount(ctx, txOwner))
+	suite.Require().True(store.Has(iqtypes.GetQueryByOwnerKey(owner, 1)))
+	suite.Require().True(store.Has(iqtypes.GetQueryByConnectionKey(connectionID, 1)))
+
+	expectedParams := iqtypes.DefaultParams()
+	expectedParams.QuerySubmitTimeout = 10
+	suite.Require().Equal(expectedParams, iqkeeper.GetParams(ctx))
+}
@@ -23,4 +23,18 @@ var (
 	ErrInvalidHeight             = sdkerrors.Register(ModuleName, 1114, "height is invalid")
 	ErrNoQueryResult             = sdkerrors.Register(ModuleName, 1115, "no query result")
 	ErrNotContract               = sdkerrors.Register(ModuleName, 1116, "not a contract")
+	ErrQueryNotDueForUpdate      = sdkerrors.Register(ModuleName, 1117, "query is not due for update")
+	ErrInvalidResultHistorySize  = sdkerrors.Register(ModuleName, 1118, "invalid result history size")
+	ErrSudoFailureNotFound       = sdkerrors.Register(ModuleName, 1119, "sudo failure not found")
+	ErrInvalidSudoFailure        = sdkerrors.Register(ModuleName, 1120, "invalid sudo failure")
+	ErrInvalidKVKeyParams        = sdkerrors.Register(ModuleName, 1121, "invalid kv key params")
+	ErrInvalidTxMessagesFilter   = sdkerrors.Register(ModuleName, 1122, "invalid transaction messages filter")
+	ErrTxDoesNotMatchFilter      = sdkerrors.Register(ModuleName, 1123, "transaction does not match query filter")
+	ErrTooManyKVQueryKeys        = sdkerrors.Register(ModuleName, 1124, "too many kv query keys")
+	ErrTooManyActiveQueries      = sdkerrors.Register(ModuleName, 1125, "too many active queries")
+	ErrUnsupportedClientType     = sdkerrors.Register(ModuleName, 1126, "unsupported client type")
+	ErrQuerySuspended            = sdkerrors.Register(ModuleName, 1127, "query is suspended")
+	ErrClientNotActive           = sdkerrors.Register(ModuleName, 1128, "client is not active")
+	ErrStaleQueryResult          = sdkerrors.Register(ModuleName, 1129, "query result is stale")
+	ErrTxQueryToRemove           = sdkerrors.Register(ModuleName, 1130, "processed transactions of tx query are being removed")
 )
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +15,142 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	queries := make(map[uint64]RegisteredQuery, len(gs.RegisteredQueries))
+	for _, query := range gs.RegisteredQueries {
+		if query.Id == 0 {
+			return sdkerrors.Wrap(ErrInvalidQueryID, "query id cannot be equal zero")
+		}
+
+		if query.Id > gs.LastRegisteredQueryId {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "query id %d is greater than last registered query id %d", query.Id, gs.LastRegisteredQueryId)
+		}
+
+		if _, ok := queries[query.Id]; ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "duplicate query id %d", query.Id)
+		}
+
+		if _, err := query.GetOwnerAddress(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid owner of query with id %d", query.Id)
+		}
+
+		if !InterchainQueryType(query.QueryType).IsValid() {
+			return sdkerrors.Wrapf(ErrInvalidQueryType, "invalid type of query with id %d: %s", query.Id, query.QueryType)
+		}
+
+		queries[query.Id] = query
+	}
+
+	results := make(map[uint64]bool, len(gs.QueryResults))
+	for _, record := range gs.QueryResults {
+		query, ok := queries[record.QueryId]
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "query result for unknown query id %d", record.QueryId)
+		}
+
+		if !InterchainQueryType(query.QueryType).IsKV() {
+			return sdkerrors.Wrapf(ErrInvalidQueryType, "query result for non-KV query with id %d", record.QueryId)
+		}
+
+		if record.Result == nil {
+			return sdkerrors.Wrapf(ErrEmptyResult, "empty query result for query id %d", record.QueryId)
+		}
+
+		if results[record.QueryId] {
+			return sdkerrors.Wrapf(ErrInvalidSubmittedResult, "duplicate query result for query id %d", record.QueryId)
+		}
+		results[record.QueryId] = true
+	}
+
+	submittedTxs := make(map[string]bool, len(gs.SubmittedTransactions))
+	for _, tx := range gs.SubmittedTransactions {
+		query, ok := queries[tx.QueryId]
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "submitted transaction for unknown query id %d", tx.QueryId)
+		}
+
+		if !InterchainQueryType(query.QueryType).IsTX() {
+			return sdkerrors.Wrapf(ErrInvalidQueryType, "submitted transaction for non-TX query with id %d", tx.QueryId)
+		}
+
+		if len(tx.TxHash) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSubmittedResult, "empty submitted transaction hash for query id %d", tx.QueryId)
+		}
+
+		key := string(GetSubmittedTransactionIDForQueryKey(tx.QueryId, tx.TxHash))
+		if submittedTxs[key] {
+			return sdkerrors.Wrapf(ErrInvalidSubmittedResult, "duplicate submitted transaction %X for query id %d", tx.TxHash, tx.QueryId)
+		}
+		submittedTxs[key] = true
+	}
+
+	historyRecords := make(map[string]bool, len(gs.QueryResultHistory))
+	for _, record := range gs.QueryResultHistory {
+		query, ok := queries[record.QueryId]
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "query result history record for unknown query id %d", record.QueryId)
+		}
+
+		if !InterchainQueryType(query.QueryType).IsKV() {
+			return sdkerrors.Wrapf(ErrInvalidQueryType, "query result history record for non-KV query with id %d", record.QueryId)
+		}
+
+		if record.Result == nil {
+			return sdkerrors.Wrapf(ErrEmptyResult, "empty query result history record for query id %d", record.QueryId)
+		}
+
+		key := string(GetQueryResultHistoryKey(record.QueryId, record.Result.Revision, record.Result.Height))
+		if historyRecords[key] {
+			return sdkerrors.Wrapf(ErrInvalidSubmittedResult, "duplicate query result history record at height %d for query id %d",
+				record.Result.Height, record.QueryId)
+		}
+		historyRecords[key] = true
+	}
+
+	failures := make(map[uint64]bool, len(gs.SudoFailures))
+	for _, failure := range gs.SudoFailures {
+		if failure.Id == 0 {
+			return sdkerrors.Wrap(ErrInvalidSudoFailure, "sudo failure id cannot be equal zero")
+		}
+
+		if failure.Id > gs.LastSudoFailureId {
+			return sdkerrors.Wrapf(ErrInvalidSudoFailure, "sudo failure id %d is greater than last sudo failure id %d", failure.Id, gs.LastSudoFailureId)
+		}
+
+		if failures[failure.Id] {
+			return sdkerrors.Wrapf(ErrInvalidSudoFailure, "duplicate sudo failure id %d", failure.Id)
+		}
+		failures[failure.Id] = true
+
+		if _, ok := queries[failure.QueryId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "sudo failure %d for unknown query id %d", failure.Id, failure.QueryId)
+		}
+	}
+
+	sequences := make(map[string]bool, len(gs.SoloMachineSequences))
+	for _, sequence := range gs.SoloMachineSequences {
+		query, ok := queries[sequence.QueryId]
+		if !ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "solo machine sequence for unknown query id %d", sequence.QueryId)
+		}
+
+		if !InterchainQueryType(query.QueryType).IsKV() {
+			return sdkerrors.Wrapf(ErrInvalidQueryType, "solo machine sequence for non-KV query with id %d", sequence.QueryId)
+		}
+
+		if err := host.ClientIdentifierValidator(sequence.ClientId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidClientID, "invalid solo machine client id of query %d: %v", sequence.QueryId, err)
+		}
+
+		key := string(GetSoloMachineSequenceKey(sequence.QueryId, sequence.ClientId))
+		if sequences[key] {
+			return sdkerrors.Wrapf(ErrInvalidClientID, "duplicate solo machine sequence of client %s for query id %d", sequence.ClientId, sequence.QueryId)
+		}
+		sequences[key] = true
+	}
+
+	return nil
 }
@@ -5,10 +5,14 @@ package types
 
 import (
 	fmt "fmt"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types3 "github.com/tendermint/tendermint/abci/types"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -34,7 +38,8 @@ type RegisteredQuery struct {
 	QueryType string `protobuf:"bytes,3,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
 	// The KV-storage keys for which we want to get values from remote chain
 	Keys []*KVKey `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
-	// The filter for transaction search ICQ
+	// The filter for transaction search ICQ. String values of the filter can't contain quotes, the values with
+	// quotes accepted before v3 are replaced with the conditions matching their parts by the v3 store migration.
 	TransactionsFilter string `protobuf:"bytes,5,opt,name=transactions_filter,json=transactionsFilter,proto3" json:"transactions_filter,omitempty"`
 	// The IBC connection ID for getting ConsensusState to verify proofs
 	ConnectionId string `protobuf:"bytes,6,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -42,12 +47,32 @@ type RegisteredQuery struct {
 	UpdatePeriod uint64 `protobuf:"varint,7,opt,name=update_period,json=updatePeriod,proto3" json:"update_period,omitempty"`
 	// The local chain last block height when the query result was updated.
 	LastSubmittedResultLocalHeight uint64 `protobuf:"varint,8,opt,name=last_submitted_result_local_height,json=lastSubmittedResultLocalHeight,proto3" json:"last_submitted_result_local_height,omitempty"`
-	// The remote chain last block height when the query result was updated.
-	LastSubmittedResultRemoteHeight uint64 `protobuf:"varint,9,opt,name=last_submitted_result_remote_height,json=lastSubmittedResultRemoteHeight,proto3" json:"last_submitted_result_remote_height,omitempty"`
 	// Amount of coins deposited for the query.
 	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 	// Timeout before query becomes available for everybody to remove.
 	SubmitTimeout uint64 `protobuf:"varint,12,opt,name=submit_timeout,json=submitTimeout,proto3" json:"submit_timeout,omitempty"`
+	// The local chain block height when the query was registered.
+	RegisteredAtHeight uint64 `protobuf:"varint,13,opt,name=registered_at_height,json=registeredAtHeight,proto3" json:"registered_at_height,omitempty"`
+	// Amount of coins paid to a relayer for every successfully submitted query result.
+	SubmissionReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=submission_reward,json=submissionReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"submission_reward"`
+	// Amount of coins escrowed to pay submission rewards to relayers. It is funded by MsgFundQueryReward
+	// and its remainder is returned to the query owner on the query removal.
+	RewardEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=reward_escrow,json=rewardEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_escrow"`
+	// The amount of the last submitted KV query results kept in the query result history.
+	// Zero value means no history is kept.
+	ResultHistorySize uint64 `protobuf:"varint,16,opt,name=result_history_size,json=resultHistorySize,proto3" json:"result_history_size,omitempty"`
+	// The structured filter the messages of the transactions submitted for a TX query are verified against
+	// on chain. Empty value means the transactions aren't verified.
+	TxMessagesFilter string `protobuf:"bytes,17,opt,name=tx_messages_filter,json=txMessagesFilter,proto3" json:"tx_messages_filter,omitempty"`
+	// The query is suspended because the IBC client of its connection is frozen or expired. Results can't be
+	// submitted for a suspended query until its connection is updated to a connection with an active client.
+	Suspended bool `protobuf:"varint,18,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// The remote chain last block height when the query result was updated. Heights are compared
+	// by revision first, so the results of the remote chain upgraded to a new revision are accepted.
+	LastSubmittedResultRemoteHeight types1.Height `protobuf:"bytes,19,opt,name=last_submitted_result_remote_height,json=lastSubmittedResultRemoteHeight,proto3" json:"last_submitted_result_remote_height"`
+	// The local height the query was last resumed at. A suspended query doesn't expire, its expiry period
+	// is counted from the height it's resumed at.
+	ResumedAtHeight uint64 `protobuf:"varint,20,opt,name=resumed_at_height,json=resumedAtHeight,proto3" json:"resumed_at_height,omitempty"`
 }
 
 func (m *RegisteredQuery) Reset()         { *m = RegisteredQuery{} }
@@ -139,23 +164,72 @@ func (m *RegisteredQuery) GetLastSubmittedResultLocalHeight() uint64 {
 	return 0
 }
 
-func (m *RegisteredQuery) GetLastSubmittedResultRemoteHeight() uint64 {
+func (m *RegisteredQuery) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.LastSubmittedResultRemoteHeight
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *RegisteredQuery) GetSubmitTimeout() uint64 {
+	if m != nil {
+		return m.SubmitTimeout
 	}
 	return 0
 }
 
-func (m *RegisteredQuery) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *RegisteredQuery) GetRegisteredAtHeight() uint64 {
 	if m != nil {
-		return m.Deposit
+		return m.RegisteredAtHeight
+	}
+	return 0
+}
+
+func (m *RegisteredQuery) GetSubmissionReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SubmissionReward
 	}
 	return nil
 }
 
-func (m *RegisteredQuery) GetSubmitTimeout() uint64 {
+func (m *RegisteredQuery) GetRewardEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.SubmitTimeout
+		return m.RewardEscrow
+	}
+	return nil
+}
+
+func (m *RegisteredQuery) GetResultHistorySize() uint64 {
+	if m != nil {
+		return m.ResultHistorySize
+	}
+	return 0
+}
+
+func (m *RegisteredQuery) GetTxMessagesFilter() string {
+	if m != nil {
+		return m.TxMessagesFilter
+	}
+	return ""
+}
+
+func (m *RegisteredQuery) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *RegisteredQuery) GetLastSubmittedResultRemoteHeight() types1.Height {
+	if m != nil {
+		return m.LastSubmittedResultRemoteHeight
+	}
+	return types1.Height{}
+}
+
+func (m *RegisteredQuery) GetResumedAtHeight() uint64 {
+	if m != nil {
+		return m.ResumedAtHeight
 	}
 	return 0
 }
@@ -214,23 +288,29 @@ func (m *KVKey) GetKey() []byte {
 	return nil
 }
 
-// GenesisState defines the interchainadapter module's genesis state.
-type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+type QueryResult struct {
+	KvResults        []*StorageValue `protobuf:"bytes,1,rep,name=kv_results,json=kvResults,proto3" json:"kv_results,omitempty"`
+	Block            *Block          `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Height           uint64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Revision         uint64          `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	AllowKvCallbacks bool            `protobuf:"varint,5,opt,name=allow_kv_callbacks,json=allowKvCallbacks,proto3" json:"allow_kv_callbacks,omitempty"`
+	// is the optional light client header of the remote chain for height + 1 the KV results are verified against;
+	// if it is set, the IBC client is updated with it before the KV results are verified
+	Header *types2.Any `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+func (m *QueryResult) Reset()         { *m = QueryResult{} }
+func (m *QueryResult) String() string { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()    {}
+func (*QueryResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_68e6c14f58b92f58, []int{2}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *QueryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -240,344 +320,3778 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *QueryResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_QueryResult proto.InternalMessageInfo
 
-func (m *GenesisState) GetParams() Params {
+func (m *QueryResult) GetKvResults() []*StorageValue {
 	if m != nil {
-		return m.Params
+		return m.KvResults
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*RegisteredQuery)(nil), "neutron.interchainadapter.interchainqueries.RegisteredQuery")
-	proto.RegisterType((*KVKey)(nil), "neutron.interchainadapter.interchainqueries.KVKey")
-	proto.RegisterType((*GenesisState)(nil), "neutron.interchainadapter.interchainqueries.GenesisState")
+func (m *QueryResult) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("interchainqueries/genesis.proto", fileDescriptor_68e6c14f58b92f58) }
-
-var fileDescriptor_68e6c14f58b92f58 = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0x8e, 0xc1, 0x40, 0xb3, 0x04, 0x5a, 0x6d, 0x39, 0xb8, 0x48, 0x75, 0x50, 0x50, 0xa5, 0x48,
-	0x15, 0x76, 0x81, 0x4b, 0xcf, 0xa9, 0x44, 0x7f, 0xe0, 0x00, 0x06, 0xf5, 0xd0, 0x8b, 0xb5, 0xb1,
-	0xa7, 0xce, 0x2a, 0xf1, 0xae, 0xbb, 0x3b, 0x6e, 0xeb, 0xb7, 0xe8, 0x73, 0xf4, 0x2d, 0x7a, 0xe3,
-	0xc8, 0xb1, 0xa7, 0xb6, 0x4a, 0x5e, 0xa4, 0xf2, 0x38, 0x11, 0x48, 0xe1, 0xc2, 0xc9, 0xe3, 0x6f,
-	0xbe, 0xef, 0x9b, 0xdd, 0x9d, 0x19, 0xd6, 0x95, 0x0a, 0xc1, 0x24, 0x23, 0x21, 0xd5, 0x97, 0x12,
-	0x8c, 0x04, 0x1b, 0x66, 0xa0, 0xc0, 0x4a, 0x1b, 0x14, 0x46, 0xa3, 0xe6, 0x2f, 0x15, 0x94, 0x68,
-	0xb4, 0x0a, 0x6e, 0x89, 0x22, 0x15, 0x05, 0x82, 0x09, 0x96, 0xa4, 0xbb, 0x3b, 0x99, 0xce, 0x34,
-	0xe9, 0xc2, 0x3a, 0x6a, 0x2c, 0x76, 0xfd, 0xe5, 0x1a, 0x85, 0x30, 0x22, 0xb7, 0x8b, 0x7c, 0xa2,
-	0x6d, 0xae, 0x6d, 0x38, 0x14, 0x16, 0xc2, 0xaf, 0x87, 0x43, 0x40, 0x71, 0x18, 0x26, 0x5a, 0xaa,
-	0x26, 0xdf, 0xfb, 0xe5, 0xb2, 0xc7, 0x11, 0x64, 0xd2, 0x22, 0x18, 0x48, 0x2f, 0x4a, 0x30, 0x15,
-	0xdf, 0x66, 0x2b, 0x32, 0xf5, 0x9c, 0x3d, 0xa7, 0xef, 0x46, 0x2b, 0x32, 0xe5, 0x3b, 0x6c, 0x4d,
-	0x7f, 0x53, 0x60, 0xbc, 0x95, 0x3d, 0xa7, 0xdf, 0x8e, 0x9a, 0x1f, 0xfe, 0x9c, 0xb1, 0xba, 0x62,
-	0x15, 0x63, 0x55, 0x80, 0xb7, 0x4a, 0xa9, 0x36, 0x21, 0x57, 0x55, 0x01, 0xfc, 0x84, 0xb9, 0x63,
-	0xa8, 0xac, 0xe7, 0xee, 0xad, 0xf6, 0x37, 0x8f, 0x8e, 0x82, 0x07, 0x5c, 0x35, 0x38, 0xfd, 0x78,
-	0x0a, 0x55, 0x44, 0x7a, 0x1e, 0xb2, 0xa7, 0x68, 0x84, 0xb2, 0x22, 0x41, 0xa9, 0x95, 0x8d, 0x3f,
-	0xcb, 0x09, 0x82, 0xf1, 0xd6, 0xa8, 0x1e, 0xbf, 0x9b, 0x3a, 0xa1, 0x0c, 0xdf, 0x67, 0x5b, 0x89,
-	0x56, 0x0a, 0x08, 0x8c, 0x65, 0xea, 0xad, 0x13, 0xb5, 0x73, 0x0b, 0xbe, 0x4f, 0x6b, 0x52, 0x59,
-	0xa4, 0x02, 0x21, 0x2e, 0xc0, 0x48, 0x9d, 0x7a, 0x1b, 0x74, 0xdb, 0x4e, 0x03, 0x9e, 0x13, 0xc6,
-	0x3f, 0xb0, 0xde, 0x44, 0x58, 0x8c, 0x6d, 0x39, 0xcc, 0x25, 0x22, 0xa4, 0xb1, 0x01, 0x5b, 0x4e,
-	0x30, 0x9e, 0xe8, 0x44, 0x4c, 0xe2, 0x11, 0xc8, 0x6c, 0x84, 0xde, 0x23, 0x52, 0xfa, 0x35, 0xf3,
-	0x72, 0x41, 0x8c, 0x88, 0x77, 0x56, 0xd3, 0xde, 0x11, 0x8b, 0x9f, 0xb1, 0xfd, 0xfb, 0xbd, 0x0c,
-	0xe4, 0x1a, 0x61, 0x61, 0xd6, 0x26, 0xb3, 0xee, 0x3d, 0x66, 0x11, 0xf1, 0xe6, 0x6e, 0xc0, 0x36,
-	0x52, 0x28, 0xb4, 0x95, 0xe8, 0x31, 0x7a, 0xdf, 0x67, 0x41, 0xd3, 0xe7, 0xa0, 0xee, 0x73, 0x30,
-	0xef, 0x73, 0xf0, 0x46, 0x4b, 0x35, 0x78, 0x75, 0xfd, 0xa7, 0xdb, 0xfa, 0xf9, 0xb7, 0xdb, 0xcf,
-	0x24, 0x8e, 0xca, 0x61, 0x90, 0xe8, 0x3c, 0x9c, 0x0f, 0x45, 0xf3, 0x39, 0xb0, 0xe9, 0x38, 0xac,
-	0x9b, 0x68, 0x49, 0x60, 0xa3, 0x85, 0x37, 0x7f, 0xc1, 0xb6, 0x9b, 0xf3, 0xc6, 0x28, 0x73, 0xd0,
-	0x25, 0x7a, 0x1d, 0x3a, 0xdf, 0x56, 0x83, 0x5e, 0x35, 0x60, 0xef, 0x80, 0xad, 0x51, 0xc7, 0x38,
-	0x67, 0x6e, 0x21, 0x70, 0x44, 0xa3, 0xd3, 0x8e, 0x28, 0xe6, 0x4f, 0xd8, 0xea, 0x18, 0x2a, 0x1a,
-	0x9d, 0x4e, 0x54, 0x87, 0x3d, 0xc1, 0x3a, 0x6f, 0x9b, 0x35, 0xb8, 0x44, 0x81, 0xc0, 0x2f, 0xd8,
-	0x7a, 0x33, 0xb2, 0xa4, 0xdb, 0x3c, 0x3a, 0x7e, 0xd0, 0xac, 0x9c, 0x93, 0x74, 0xe0, 0xd6, 0xb7,
-	0x8c, 0xe6, 0x46, 0x83, 0xe8, 0x7a, 0xea, 0x3b, 0x37, 0x53, 0xdf, 0xf9, 0x37, 0xf5, 0x9d, 0x1f,
-	0x33, 0xbf, 0x75, 0x33, 0xf3, 0x5b, 0xbf, 0x67, 0x7e, 0xeb, 0xd3, 0xeb, 0x3b, 0xaf, 0x30, 0x2f,
-	0x73, 0xa0, 0x4d, 0xb6, 0x88, 0xc3, 0xef, 0xe1, 0xf2, 0x42, 0xd1, 0xdb, 0x0c, 0xd7, 0x69, 0x61,
-	0x8e, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x39, 0x69, 0xa0, 0xd6, 0x03, 0x00, 0x00,
+func (m *QueryResult) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *RegisteredQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResult) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RegisteredQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResult) GetAllowKvCallbacks() bool {
+	if m != nil {
+		return m.AllowKvCallbacks
+	}
+	return false
 }
 
-func (m *RegisteredQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubmitTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmitTimeout))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.Deposit) > 0 {
-		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.LastSubmittedResultRemoteHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSubmittedResultRemoteHeight))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.LastSubmittedResultLocalHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSubmittedResultLocalHeight))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.UpdatePeriod != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdatePeriod))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TransactionsFilter) > 0 {
-		i -= len(m.TransactionsFilter)
-		copy(dAtA[i:], m.TransactionsFilter)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TransactionsFilter)))
-		i--
-		dAtA[i] = 0x2a
+func (m *QueryResult) GetHeader() *types2.Any {
+	if m != nil {
+		return m.Header
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return nil
+}
+
+type StorageValue struct {
+	// is the substore name (acc, staking, etc.)
+	StoragePrefix string `protobuf:"bytes,1,opt,name=storage_prefix,json=storagePrefix,proto3" json:"storage_prefix,omitempty"`
+	// is the key in IAVL store
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// is the value in IAVL store
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// is the Merkle Proof which proves existence of key-value pair in IAVL storage
+	Proof *crypto.ProofOps `protobuf:"bytes,4,opt,name=Proof,proto3" json:"Proof,omitempty"`
+}
+
+func (m *StorageValue) Reset()         { *m = StorageValue{} }
+func (m *StorageValue) String() string { return proto.CompactTextString(m) }
+func (*StorageValue) ProtoMessage()    {}
+func (*StorageValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{3}
+}
+func (m *StorageValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.QueryType) > 0 {
-		i -= len(m.QueryType)
-		copy(dAtA[i:], m.QueryType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *StorageValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageValue.Merge(m, src)
+}
+func (m *StorageValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageValue.DiscardUnknown(m)
 }
 
-func (m *KVKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StorageValue proto.InternalMessageInfo
+
+func (m *StorageValue) GetStoragePrefix() string {
+	if m != nil {
+		return m.StoragePrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *KVKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StorageValue) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *KVKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+func (m *StorageValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StorageValue) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Block struct {
+	// We need to know block X+1 to verify response of transaction for block X
+	// since LastResultsHash is root hash of all results from the txs from the previous block
+	NextBlockHeader *types2.Any `protobuf:"bytes,1,opt,name=next_block_header,json=nextBlockHeader,proto3" json:"next_block_header,omitempty"`
+	// We need to know block X to verify inclusion of transaction for block X
+	Header *types2.Any `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Tx     *TxValue    `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	// are the transactions of the block submitted at once along with tx, all of them are verified against the
+	// same pair of headers
+	Txs []*TxValue `protobuf:"bytes,4,rep,name=txs,proto3" json:"txs,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{4}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return m.Size()
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetNextBlockHeader() *types2.Any {
+	if m != nil {
+		return m.NextBlockHeader
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *RegisteredQuery) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Block) GetHeader() *types2.Any {
+	if m != nil {
+		return m.Header
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+	return nil
+}
+
+func (m *Block) GetTx() *TxValue {
+	if m != nil {
+		return m.Tx
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *Block) GetTxs() []*TxValue {
+	if m != nil {
+		return m.Txs
 	}
-	l = len(m.QueryType)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+type TxValue struct {
+	Response *types3.ResponseDeliverTx `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// is the Merkle Proof which proves existence of response in block with height next_block_header.Height
+	DeliveryProof *crypto.Proof `protobuf:"bytes,2,opt,name=delivery_proof,json=deliveryProof,proto3" json:"delivery_proof,omitempty"`
+	// is the Merkle Proof which proves existence of data in block with height header.Height
+	InclusionProof *crypto.Proof `protobuf:"bytes,3,opt,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`
+	// is body of the transaction
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *TxValue) Reset()         { *m = TxValue{} }
+func (m *TxValue) String() string { return proto.CompactTextString(m) }
+func (*TxValue) ProtoMessage()    {}
+func (*TxValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{5}
+}
+func (m *TxValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *TxValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxValue.Merge(m, src)
+}
+func (m *TxValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxValue proto.InternalMessageInfo
+
+func (m *TxValue) GetResponse() *types3.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TxValue) GetDeliveryProof() *crypto.Proof {
+	if m != nil {
+		return m.DeliveryProof
+	}
+	return nil
+}
+
+func (m *TxValue) GetInclusionProof() *crypto.Proof {
+	if m != nil {
+		return m.InclusionProof
+	}
+	return nil
+}
+
+func (m *TxValue) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// GenesisState defines the interchainadapter module's genesis state.
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The id of the last registered query, new queries get ids starting from the next one.
+	LastRegisteredQueryId uint64 `protobuf:"varint,2,opt,name=last_registered_query_id,json=lastRegisteredQueryId,proto3" json:"last_registered_query_id,omitempty"`
+	// All the queries registered in the module.
+	RegisteredQueries []RegisteredQuery `protobuf:"bytes,3,rep,name=registered_queries,json=registeredQueries,proto3" json:"registered_queries"`
+	// The last submitted results of the registered KV queries.
+	QueryResults []QueryResultRecord `protobuf:"bytes,4,rep,name=query_results,json=queryResults,proto3" json:"query_results"`
+	// The transactions already processed by the registered TX queries.
+	SubmittedTransactions []SubmittedTransaction `protobuf:"bytes,5,rep,name=submitted_transactions,json=submittedTransactions,proto3" json:"submitted_transactions"`
+	// The results kept in the result history of the registered KV queries.
+	QueryResultHistory []QueryResultRecord `protobuf:"bytes,6,rep,name=query_result_history,json=queryResultHistory,proto3" json:"query_result_history"`
+	// The id of the last recorded sudo failure, new failures get ids starting from the next one.
+	LastSudoFailureId uint64 `protobuf:"varint,7,opt,name=last_sudo_failure_id,json=lastSudoFailureId,proto3" json:"last_sudo_failure_id,omitempty"`
+	// The failed calls of the query owner contracts sudo handlers with the submitted query results.
+	SudoFailures []SudoFailure `protobuf:"bytes,8,rep,name=sudo_failures,json=sudoFailures,proto3" json:"sudo_failures"`
+	// The last solo machine sequences the results of the registered KV queries are verified at.
+	SoloMachineSequences []SoloMachineSequence `protobuf:"bytes,9,rep,name=solo_machine_sequences,json=soloMachineSequences,proto3" json:"solo_machine_sequences"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{6}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.TransactionsFilter)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return Params{}
+}
+
+func (m *GenesisState) GetLastRegisteredQueryId() uint64 {
+	if m != nil {
+		return m.LastRegisteredQueryId
 	}
-	if m.UpdatePeriod != 0 {
-		n += 1 + sovGenesis(uint64(m.UpdatePeriod))
+	return 0
+}
+
+func (m *GenesisState) GetRegisteredQueries() []RegisteredQuery {
+	if m != nil {
+		return m.RegisteredQueries
 	}
-	if m.LastSubmittedResultLocalHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSubmittedResultLocalHeight))
+	return nil
+}
+
+func (m *GenesisState) GetQueryResults() []QueryResultRecord {
+	if m != nil {
+		return m.QueryResults
 	}
-	if m.LastSubmittedResultRemoteHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.LastSubmittedResultRemoteHeight))
+	return nil
+}
+
+func (m *GenesisState) GetSubmittedTransactions() []SubmittedTransaction {
+	if m != nil {
+		return m.SubmittedTransactions
 	}
-	if len(m.Deposit) > 0 {
-		for _, e := range m.Deposit {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+func (m *GenesisState) GetQueryResultHistory() []QueryResultRecord {
+	if m != nil {
+		return m.QueryResultHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSudoFailureId() uint64 {
+	if m != nil {
+		return m.LastSudoFailureId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSudoFailures() []SudoFailure {
+	if m != nil {
+		return m.SudoFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSoloMachineSequences() []SoloMachineSequence {
+	if m != nil {
+		return m.SoloMachineSequences
+	}
+	return nil
+}
+
+// QueryResultRecord binds a stored query result to the id of its query.
+type QueryResultRecord struct {
+	QueryId uint64       `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Result  *QueryResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *QueryResultRecord) Reset()         { *m = QueryResultRecord{} }
+func (m *QueryResultRecord) String() string { return proto.CompactTextString(m) }
+func (*QueryResultRecord) ProtoMessage()    {}
+func (*QueryResultRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{7}
+}
+func (m *QueryResultRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResultRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResultRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SubmitTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.SubmitTimeout))
+}
+func (m *QueryResultRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResultRecord.Merge(m, src)
+}
+func (m *QueryResultRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResultRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResultRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResultRecord proto.InternalMessageInfo
+
+func (m *QueryResultRecord) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
 	}
-	return n
+	return 0
 }
 
-func (m *KVKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResultRecord) GetResult() *QueryResult {
+	if m != nil {
+		return m.Result
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return nil
+}
+
+// SudoFailure is a failed call of the sudo handler of a query owner contract with a submitted query result.
+type SudoFailure struct {
+	// is the unique id of the failure
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueryId uint64 `protobuf:"varint,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// is the address of the contract which has failed to process the query result. The failure is retried
+	// with the current query owner, the query might have been transferred to another owner since the failure.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// is the JSON message passed to the contract sudo handler
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// is the error the contract sudo handler has failed with
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// is the local height the failure has happened at
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SudoFailure) Reset()         { *m = SudoFailure{} }
+func (m *SudoFailure) String() string { return proto.CompactTextString(m) }
+func (*SudoFailure) ProtoMessage()    {}
+func (*SudoFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{8}
+}
+func (m *SudoFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *SudoFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoFailure.Merge(m, src)
+}
+func (m *SudoFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoFailure proto.InternalMessageInfo
+
+func (m *SudoFailure) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SudoFailure) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *SudoFailure) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SudoFailure) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SudoFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SudoFailure) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SubmittedTransaction is a marker of a transaction processed by a TX query.
+type SubmittedTransaction struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	TxHash  []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *SubmittedTransaction) Reset()         { *m = SubmittedTransaction{} }
+func (m *SubmittedTransaction) String() string { return proto.CompactTextString(m) }
+func (*SubmittedTransaction) ProtoMessage()    {}
+func (*SubmittedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{9}
+}
+func (m *SubmittedTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmittedTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmittedTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmittedTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmittedTransaction.Merge(m, src)
+}
+func (m *SubmittedTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmittedTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmittedTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmittedTransaction proto.InternalMessageInfo
+
+func (m *SubmittedTransaction) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *SubmittedTransaction) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+// SoloMachineSequence is the last sequence of a solo machine client the KV results of a query are verified at,
+// the results of the query obtained at lower or equal sequences of the client are rejected.
+type SoloMachineSequence struct {
+	QueryId  uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *SoloMachineSequence) Reset()         { *m = SoloMachineSequence{} }
+func (m *SoloMachineSequence) String() string { return proto.CompactTextString(m) }
+func (*SoloMachineSequence) ProtoMessage()    {}
+func (*SoloMachineSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68e6c14f58b92f58, []int{10}
+}
+func (m *SoloMachineSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SoloMachineSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SoloMachineSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SoloMachineSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoloMachineSequence.Merge(m, src)
+}
+func (m *SoloMachineSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SoloMachineSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoloMachineSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoloMachineSequence proto.InternalMessageInfo
+
+func (m *SoloMachineSequence) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *SoloMachineSequence) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *SoloMachineSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RegisteredQuery)(nil), "neutron.interchainadapter.interchainqueries.RegisteredQuery")
+	proto.RegisterType((*KVKey)(nil), "neutron.interchainadapter.interchainqueries.KVKey")
+	proto.RegisterType((*QueryResult)(nil), "neutron.interchainadapter.interchainqueries.QueryResult")
+	proto.RegisterType((*StorageValue)(nil), "neutron.interchainadapter.interchainqueries.StorageValue")
+	proto.RegisterType((*Block)(nil), "neutron.interchainadapter.interchainqueries.Block")
+	proto.RegisterType((*TxValue)(nil), "neutron.interchainadapter.interchainqueries.TxValue")
+	proto.RegisterType((*GenesisState)(nil), "neutron.interchainadapter.interchainqueries.GenesisState")
+	proto.RegisterType((*QueryResultRecord)(nil), "neutron.interchainadapter.interchainqueries.QueryResultRecord")
+	proto.RegisterType((*SudoFailure)(nil), "neutron.interchainadapter.interchainqueries.SudoFailure")
+	proto.RegisterType((*SubmittedTransaction)(nil), "neutron.interchainadapter.interchainqueries.SubmittedTransaction")
+	proto.RegisterType((*SoloMachineSequence)(nil), "neutron.interchainadapter.interchainqueries.SoloMachineSequence")
+}
+
+func init() { proto.RegisterFile("interchainqueries/genesis.proto", fileDescriptor_68e6c14f58b92f58) }
+
+var fileDescriptor_68e6c14f58b92f58 = []byte{
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6e, 0x13, 0x47,
+	0x17, 0x8f, 0x1d, 0xc7, 0xb1, 0x27, 0x76, 0xfe, 0x4c, 0x0c, 0xdf, 0x12, 0x3e, 0x9c, 0xc8, 0xe8,
+	0x93, 0xa2, 0xaf, 0xb0, 0x4b, 0x42, 0xa5, 0x52, 0xa9, 0xa2, 0x24, 0xd0, 0x34, 0x40, 0x51, 0xc3,
+	0x26, 0x42, 0x55, 0x6f, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0xf2, 0x7a, 0x67, 0x33, 0x33, 0xeb, 0xd8,
+	0xa8, 0xaa, 0x7a, 0xd1, 0xde, 0xf3, 0x00, 0x7d, 0x82, 0xde, 0xf4, 0x19, 0x7a, 0xc7, 0x25, 0x97,
+	0xbd, 0xea, 0x1f, 0x78, 0x91, 0x6a, 0xcf, 0xcc, 0xda, 0x0b, 0x49, 0x41, 0x46, 0x5c, 0x79, 0xe7,
+	0x9c, 0x33, 0xbf, 0xf3, 0x77, 0xce, 0x39, 0x46, 0xeb, 0x2c, 0x52, 0x54, 0xf8, 0x5d, 0xc2, 0xa2,
+	0x93, 0x84, 0x0a, 0x46, 0xa5, 0xd3, 0xa1, 0x11, 0x95, 0x4c, 0xda, 0xb1, 0xe0, 0x8a, 0xe3, 0x8f,
+	0x22, 0x9a, 0x28, 0xc1, 0x23, 0x7b, 0x22, 0x48, 0x02, 0x12, 0x2b, 0x2a, 0xec, 0x33, 0x57, 0xd7,
+	0x1a, 0x1d, 0xde, 0xe1, 0x70, 0xcf, 0x49, 0xbf, 0x34, 0xc4, 0x5a, 0xf3, 0xac, 0x8e, 0x98, 0x08,
+	0xd2, 0x97, 0x19, 0xdf, 0xe7, 0xb2, 0xcf, 0xa5, 0xd3, 0x26, 0x92, 0x3a, 0x83, 0xad, 0x36, 0x55,
+	0x64, 0xcb, 0xf1, 0x39, 0x8b, 0x0c, 0xff, 0x8a, 0xa2, 0x51, 0x40, 0x45, 0x9f, 0x45, 0xca, 0xf1,
+	0xc5, 0x28, 0x56, 0xdc, 0x89, 0x05, 0xe7, 0xc7, 0x86, 0x7d, 0x39, 0xc7, 0x26, 0x6d, 0x9f, 0x39,
+	0x6a, 0x14, 0xd3, 0x0c, 0xfb, 0x52, 0x87, 0xf3, 0x4e, 0x48, 0x1d, 0x38, 0xb5, 0x93, 0x63, 0x87,
+	0x44, 0x23, 0xc3, 0x5a, 0x67, 0x6d, 0xdf, 0xf1, 0xb9, 0xa0, 0x8e, 0x1f, 0x32, 0x1a, 0x29, 0x67,
+	0xb0, 0x65, 0xbe, 0xb4, 0x40, 0xeb, 0xd7, 0x0a, 0x5a, 0x72, 0x69, 0x87, 0x49, 0x45, 0x05, 0x0d,
+	0x1e, 0x27, 0x54, 0x8c, 0xf0, 0x22, 0x2a, 0xb2, 0xc0, 0x2a, 0x6c, 0x14, 0x36, 0x4b, 0x6e, 0x91,
+	0x05, 0xb8, 0x81, 0xe6, 0xf8, 0x69, 0x44, 0x85, 0x55, 0xdc, 0x28, 0x6c, 0x56, 0x5d, 0x7d, 0xc0,
+	0x57, 0x10, 0x4a, 0x3d, 0x1d, 0x79, 0xa9, 0x29, 0xd6, 0x2c, 0xb0, 0xaa, 0x40, 0x39, 0x1a, 0xc5,
+	0x14, 0xef, 0xa1, 0x52, 0x8f, 0x8e, 0xa4, 0x55, 0xda, 0x98, 0xdd, 0x5c, 0xd8, 0xde, 0xb6, 0xa7,
+	0x08, 0xb1, 0xfd, 0xf0, 0xc9, 0x43, 0x3a, 0x72, 0xe1, 0x3e, 0x76, 0xd0, 0xaa, 0x12, 0x24, 0x92,
+	0xc4, 0x57, 0x8c, 0x47, 0xd2, 0x3b, 0x66, 0xa1, 0xa2, 0xc2, 0x9a, 0x03, 0x7d, 0x38, 0xcf, 0xda,
+	0x03, 0x0e, 0xbe, 0x8a, 0xea, 0x3e, 0x8f, 0x22, 0x0a, 0x44, 0x8f, 0x05, 0x56, 0x19, 0x44, 0x6b,
+	0x13, 0xe2, 0xfd, 0x20, 0x15, 0x4a, 0xe2, 0x80, 0x28, 0xea, 0xc5, 0x54, 0x30, 0x1e, 0x58, 0xf3,
+	0xe0, 0x6d, 0x4d, 0x13, 0x0f, 0x80, 0x86, 0x1f, 0xa0, 0x56, 0x48, 0xa4, 0xf2, 0x64, 0xd2, 0xee,
+	0x33, 0xa5, 0x68, 0xe0, 0x09, 0x2a, 0x93, 0x50, 0x79, 0x21, 0xf7, 0x49, 0xe8, 0x75, 0x29, 0xeb,
+	0x74, 0x95, 0x55, 0x81, 0x9b, 0xcd, 0x54, 0xf2, 0x30, 0x13, 0x74, 0x41, 0xee, 0xab, 0x54, 0x6c,
+	0x1f, 0xa4, 0x30, 0x45, 0xf3, 0x01, 0x8d, 0xb9, 0x64, 0xca, 0x42, 0x10, 0x91, 0x4b, 0xb6, 0xae,
+	0x08, 0x3b, 0xad, 0x08, 0xdb, 0x54, 0x84, 0x7d, 0x97, 0xb3, 0x68, 0xf7, 0xc6, 0xf3, 0x3f, 0xd6,
+	0x67, 0x7e, 0xf9, 0x73, 0x7d, 0xb3, 0xc3, 0x54, 0x37, 0x69, 0xdb, 0x3e, 0xef, 0x3b, 0xa6, 0x7c,
+	0xf4, 0xcf, 0x75, 0x19, 0xf4, 0x4c, 0x05, 0xa4, 0x17, 0xa4, 0x9b, 0x61, 0xe3, 0xff, 0xa1, 0x45,
+	0x6d, 0xad, 0xa7, 0x58, 0x9f, 0xf2, 0x44, 0x59, 0x35, 0x30, 0xaf, 0xae, 0xa9, 0x47, 0x9a, 0x88,
+	0x6f, 0xa0, 0x86, 0x18, 0x27, 0xdd, 0x23, 0x2a, 0xf3, 0xa5, 0x0e, 0xc2, 0x78, 0xc2, 0xdb, 0x51,
+	0xc6, 0xfe, 0x21, 0x5a, 0x01, 0x08, 0x29, 0xd3, 0xa8, 0x0a, 0x7a, 0x4a, 0x44, 0x60, 0x2d, 0x7e,
+	0x78, 0x4f, 0x96, 0x27, 0x5a, 0x5c, 0x50, 0x82, 0x63, 0x54, 0xd7, 0xea, 0x3c, 0x2a, 0x7d, 0xc1,
+	0x4f, 0xad, 0xa5, 0x0f, 0xaf, 0xb5, 0xa6, 0x35, 0x7c, 0x01, 0x0a, 0xb0, 0x8d, 0x56, 0x4d, 0xa2,
+	0xbb, 0x4c, 0x2a, 0x2e, 0x46, 0x9e, 0x64, 0x4f, 0xa9, 0xb5, 0x0c, 0xc1, 0x59, 0xd1, 0xac, 0x7d,
+	0xcd, 0x39, 0x64, 0x4f, 0x29, 0xbe, 0x86, 0xb0, 0x1a, 0x7a, 0x7d, 0x2a, 0x25, 0xe9, 0xd0, 0x71,
+	0x85, 0xae, 0x40, 0xd9, 0x2d, 0xab, 0xe1, 0x23, 0xc3, 0x30, 0xf5, 0xf9, 0x5f, 0x54, 0x95, 0x89,
+	0x8c, 0xd3, 0xf7, 0x1c, 0x58, 0x78, 0xa3, 0xb0, 0x59, 0x71, 0x27, 0x04, 0x1c, 0xa1, 0xab, 0xe7,
+	0xd7, 0x9c, 0xa0, 0x7d, 0xae, 0x68, 0x96, 0xa8, 0xd5, 0x8d, 0xc2, 0xe6, 0xc2, 0xf6, 0x9a, 0xcd,
+	0xda, 0xbe, 0x9d, 0x3e, 0x6f, 0xdb, 0x3c, 0xea, 0xc1, 0x96, 0xad, 0x13, 0xb6, 0x5b, 0x4a, 0x83,
+	0xe0, 0xae, 0x9f, 0x53, 0x96, 0x2e, 0x20, 0x99, 0xbc, 0xfe, 0x1f, 0x81, 0x43, 0xfd, 0xd7, 0xca,
+	0xa0, 0x01, 0x9e, 0x2e, 0x19, 0x46, 0x56, 0x03, 0x0f, 0x4a, 0x95, 0xea, 0x32, 0x6a, 0x5d, 0x47,
+	0x73, 0xf0, 0x3e, 0x31, 0x46, 0xa5, 0x98, 0xa8, 0x2e, 0x34, 0x8a, 0xaa, 0x0b, 0xdf, 0x78, 0x19,
+	0xcd, 0xf6, 0xe8, 0x08, 0x1a, 0x45, 0xcd, 0x4d, 0x3f, 0x5b, 0xbf, 0x15, 0xd1, 0x02, 0xb4, 0x15,
+	0xad, 0x1c, 0x7f, 0x83, 0x50, 0x6f, 0x60, 0x9c, 0x92, 0x56, 0x01, 0x72, 0xf9, 0xe9, 0x54, 0xdd,
+	0xe1, 0x50, 0x71, 0x41, 0x3a, 0xf4, 0x09, 0x09, 0x13, 0xea, 0x56, 0x7b, 0x03, 0x0d, 0x2c, 0xf1,
+	0x3e, 0x9a, 0x6b, 0x87, 0xdc, 0xef, 0x81, 0xf6, 0x69, 0x5b, 0xce, 0x6e, 0x7a, 0xd3, 0xd5, 0x00,
+	0xf8, 0x22, 0x2a, 0x9b, 0x48, 0xcc, 0x42, 0x24, 0xcc, 0x09, 0xaf, 0xa1, 0x8a, 0xa0, 0x03, 0x96,
+	0x16, 0xa7, 0x55, 0x02, 0xce, 0xf8, 0x9c, 0x16, 0x01, 0x09, 0x43, 0x7e, 0xea, 0xf5, 0x06, 0x9e,
+	0x4f, 0xc2, 0xb0, 0x4d, 0xfc, 0x9e, 0x84, 0x36, 0x55, 0x71, 0x97, 0x81, 0xf3, 0x70, 0x70, 0x37,
+	0xa3, 0xe3, 0x6b, 0xa9, 0x06, 0x12, 0x50, 0x01, 0xdd, 0x69, 0x61, 0xbb, 0x61, 0xeb, 0x1e, 0x6e,
+	0x67, 0x3d, 0xdc, 0xde, 0x89, 0x46, 0xae, 0x91, 0x69, 0x3d, 0x2b, 0xa0, 0x5a, 0xde, 0x6b, 0x78,
+	0xe6, 0xfa, 0xec, 0xc5, 0x82, 0x1e, 0xb3, 0xa1, 0x49, 0x42, 0xdd, 0x50, 0x0f, 0x80, 0x78, 0x36,
+	0x1b, 0x69, 0x2b, 0x1f, 0xa4, 0x08, 0xe0, 0x58, 0xcd, 0xd5, 0x07, 0xbc, 0x85, 0xe6, 0x0e, 0xd2,
+	0x61, 0x03, 0x4e, 0x2d, 0x6c, 0x5f, 0xb6, 0x27, 0xd3, 0xc6, 0xd6, 0xc3, 0xc8, 0x06, 0xfe, 0xd7,
+	0xb1, 0x74, 0xb5, 0x64, 0xeb, 0xa7, 0x22, 0x9a, 0x83, 0x98, 0xe1, 0x3b, 0x68, 0x25, 0xa2, 0x43,
+	0xe5, 0x41, 0xe8, 0x3c, 0xe3, 0x55, 0xe1, 0x2d, 0x5e, 0x2d, 0xa5, 0xe2, 0x70, 0x77, 0x1f, 0x84,
+	0x73, 0xc1, 0x28, 0xbe, 0x3b, 0x18, 0xf8, 0x1e, 0x2a, 0xaa, 0x21, 0xd8, 0xbf, 0xb0, 0xfd, 0xf1,
+	0x54, 0x39, 0x3e, 0x1a, 0xea, 0x9a, 0x29, 0xaa, 0x21, 0xde, 0x43, 0xb3, 0x6a, 0x98, 0x4d, 0xa7,
+	0xf7, 0x83, 0x49, 0x01, 0x5a, 0x7f, 0x17, 0xd0, 0xbc, 0x21, 0xe0, 0xdb, 0x69, 0x79, 0xc8, 0x98,
+	0x47, 0x92, 0x9a, 0x00, 0xb4, 0xf2, 0x91, 0x4c, 0xe7, 0xb6, 0xed, 0x1a, 0x81, 0x7b, 0x34, 0x64,
+	0x03, 0x2a, 0x8e, 0x86, 0xee, 0xf8, 0x0e, 0xfe, 0x1c, 0x2d, 0x06, 0x9a, 0x3c, 0xf2, 0x60, 0xf8,
+	0x9b, 0x78, 0x58, 0xff, 0x96, 0x0f, 0xb7, 0x9e, 0xc9, 0xc3, 0x11, 0xef, 0xa0, 0x25, 0x16, 0xf9,
+	0x61, 0x02, 0x3d, 0x5a, 0x23, 0xcc, 0xbe, 0x03, 0x61, 0x71, 0x7c, 0x41, 0x43, 0x60, 0x54, 0x0a,
+	0x88, 0x22, 0x50, 0x09, 0x35, 0x17, 0xbe, 0x5b, 0x3f, 0xce, 0xa3, 0xda, 0x97, 0x7a, 0x61, 0x3a,
+	0x54, 0x44, 0x51, 0xfc, 0x18, 0x95, 0xf5, 0x72, 0x63, 0xdc, 0xbc, 0x39, 0x55, 0xfc, 0x0e, 0xe0,
+	0xaa, 0x69, 0x50, 0x06, 0x08, 0x7f, 0x82, 0x2c, 0xe8, 0x7b, 0xb9, 0xb1, 0xa4, 0xb7, 0x0b, 0x16,
+	0x40, 0x14, 0x4a, 0xee, 0x85, 0x94, 0xff, 0xc6, 0xaa, 0x72, 0x3f, 0xc0, 0x27, 0x08, 0xbf, 0x71,
+	0x87, 0x51, 0x69, 0xcd, 0x42, 0x5e, 0x3f, 0x9b, 0xca, 0xae, 0x37, 0xb0, 0x8d, 0x81, 0x2b, 0xe2,
+	0x35, 0x32, 0xa3, 0x12, 0x33, 0x54, 0xd7, 0xb6, 0x65, 0x5d, 0x4c, 0x57, 0xd1, 0xed, 0xa9, 0xb4,
+	0xe5, 0x7a, 0xa2, 0x4b, 0x7d, 0x2e, 0x02, 0xa3, 0xaf, 0x76, 0x32, 0x61, 0x48, 0xfc, 0x3d, 0xba,
+	0x38, 0x99, 0x04, 0xf9, 0x65, 0xc7, 0x9a, 0x03, 0x9d, 0x3b, 0xd3, 0x75, 0xce, 0x0c, 0xea, 0x68,
+	0x82, 0x64, 0xd4, 0x5e, 0x90, 0xe7, 0xf0, 0x24, 0x1e, 0xa0, 0x46, 0xde, 0xd5, 0x6c, 0x20, 0x5a,
+	0xe5, 0x0f, 0xe8, 0x31, 0xce, 0x79, 0x6c, 0xc6, 0x2a, 0x76, 0x50, 0xc3, 0x8c, 0xc1, 0x80, 0x7b,
+	0xc7, 0x84, 0x85, 0x89, 0xa0, 0x1e, 0xcb, 0xd6, 0xb4, 0x15, 0x3d, 0xd5, 0x02, 0xbe, 0xa7, 0x39,
+	0xf7, 0x03, 0xec, 0xa3, 0x7a, 0x5e, 0x56, 0x5a, 0x15, 0xb0, 0xf0, 0xd6, 0x94, 0xf1, 0x19, 0x43,
+	0x66, 0xd9, 0x90, 0x13, 0x92, 0xc4, 0xdf, 0xa1, 0x8b, 0x92, 0x87, 0xdc, 0xeb, 0x13, 0xbf, 0xcb,
+	0x22, 0xea, 0x49, 0x7a, 0x92, 0xd0, 0xc8, 0xa7, 0xd2, 0xaa, 0x82, 0xb6, 0x3b, 0xd3, 0x69, 0xe3,
+	0x21, 0x7f, 0xa4, 0x91, 0x0e, 0x0d, 0x90, 0xd1, 0xda, 0x90, 0x67, 0x59, 0xb2, 0xf5, 0x43, 0x01,
+	0xad, 0x9c, 0x89, 0x21, 0xbe, 0x84, 0x2a, 0xe3, 0x87, 0xa2, 0x57, 0xf6, 0xf9, 0x13, 0xf3, 0x34,
+	0x0e, 0x50, 0x59, 0xa7, 0xcd, 0xf4, 0x91, 0x5b, 0xef, 0x9d, 0x2e, 0x83, 0xd3, 0xfa, 0xb9, 0x80,
+	0x16, 0x72, 0x41, 0x3a, 0xf3, 0x4f, 0x21, 0x6f, 0x4c, 0xf1, 0x75, 0x63, 0xd6, 0x50, 0xc5, 0xe7,
+	0x91, 0x12, 0xc4, 0x57, 0xe6, 0xcf, 0xc2, 0xf8, 0x8c, 0x2d, 0x34, 0x1f, 0x93, 0x51, 0xc8, 0x49,
+	0x60, 0xfa, 0x4e, 0x76, 0x4c, 0xe7, 0x15, 0x15, 0x82, 0x67, 0xfb, 0xbe, 0x3e, 0xe4, 0xe6, 0x73,
+	0x39, 0x3f, 0x9f, 0x5b, 0x0f, 0x50, 0xe3, 0xbc, 0x12, 0x7f, 0x5b, 0x8c, 0xfe, 0x83, 0xe6, 0xd5,
+	0xd0, 0xeb, 0x12, 0xd9, 0x35, 0x63, 0xb2, 0xac, 0x86, 0xfb, 0x44, 0x76, 0x5b, 0x0c, 0xad, 0x9e,
+	0x93, 0xa0, 0xb7, 0x41, 0x5d, 0x46, 0x55, 0xbd, 0x85, 0x65, 0xde, 0xa7, 0x2e, 0x02, 0x41, 0xbb,
+	0x9f, 0x55, 0x8b, 0x59, 0x2a, 0xc6, 0xe7, 0x5d, 0xf7, 0xf9, 0xcb, 0x66, 0xe1, 0xc5, 0xcb, 0x66,
+	0xe1, 0xaf, 0x97, 0xcd, 0xc2, 0xb3, 0x57, 0xcd, 0x99, 0x17, 0xaf, 0x9a, 0x33, 0xbf, 0xbf, 0x6a,
+	0xce, 0x7c, 0x7b, 0x2b, 0xb7, 0xc1, 0x9a, 0xdc, 0x5d, 0xe7, 0xa2, 0x93, 0x7d, 0x3b, 0x43, 0xe7,
+	0xec, 0xdf, 0x4e, 0xd8, 0x6b, 0xdb, 0x65, 0x98, 0x9d, 0x37, 0xff, 0x19, 0x00, 0x80, 0x2d, 0xbc,
+	0x01, 0xfc, 0x0e, 0x00, 0x00,
+}
+
+func (m *RegisteredQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumedAtHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ResumedAtHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size, err := m.LastSubmittedResultRemoteHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.TxMessagesFilter) > 0 {
+		i -= len(m.TxMessagesFilter)
+		copy(dAtA[i:], m.TxMessagesFilter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxMessagesFilter)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ResultHistorySize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ResultHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RewardEscrow) > 0 {
+		for iNdEx := len(m.RewardEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SubmissionReward) > 0 {
+		for iNdEx := len(m.SubmissionReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmissionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RegisteredAtHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RegisteredAtHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SubmitTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SubmitTimeout))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LastSubmittedResultLocalHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSubmittedResultLocalHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdatePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UpdatePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TransactionsFilter) > 0 {
+		i -= len(m.TransactionsFilter)
+		copy(dAtA[i:], m.TransactionsFilter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TransactionsFilter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AllowKvCallbacks {
+		i--
+		if m.AllowKvCallbacks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Revision != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KvResults) > 0 {
+		for iNdEx := len(m.KvResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KvResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoragePrefix) > 0 {
+		i -= len(m.StoragePrefix)
+		copy(dAtA[i:], m.StoragePrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StoragePrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NextBlockHeader != nil {
+		{
+			size, err := m.NextBlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InclusionProof != nil {
+		{
+			size, err := m.InclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DeliveryProof != nil {
+		{
+			size, err := m.DeliveryProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SoloMachineSequences) > 0 {
+		for iNdEx := len(m.SoloMachineSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SoloMachineSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SudoFailures) > 0 {
+		for iNdEx := len(m.SudoFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastSudoFailureId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSudoFailureId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.QueryResultHistory) > 0 {
+		for iNdEx := len(m.QueryResultHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryResultHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SubmittedTransactions) > 0 {
+		for iNdEx := len(m.SubmittedTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmittedTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QueryResults) > 0 {
+		for iNdEx := len(m.QueryResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegisteredQueries) > 0 {
+		for iNdEx := len(m.RegisteredQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastRegisteredQueryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRegisteredQueryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResultRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResultRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmittedTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmittedTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmittedTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SoloMachineSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SoloMachineSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SoloMachineSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisteredQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.TransactionsFilter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UpdatePeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.UpdatePeriod))
+	}
+	if m.LastSubmittedResultLocalHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSubmittedResultLocalHeight))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SubmitTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.SubmitTimeout))
+	}
+	if m.RegisteredAtHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.RegisteredAtHeight))
+	}
+	if len(m.SubmissionReward) > 0 {
+		for _, e := range m.SubmissionReward {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardEscrow) > 0 {
+		for _, e := range m.RewardEscrow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ResultHistorySize != 0 {
+		n += 2 + sovGenesis(uint64(m.ResultHistorySize))
+	}
+	l = len(m.TxMessagesFilter)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.Suspended {
+		n += 3
+	}
+	l = m.LastSubmittedResultRemoteHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ResumedAtHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.ResumedAtHeight))
+	}
+	return n
+}
+
+func (m *KVKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KvResults) > 0 {
+		for _, e := range m.KvResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovGenesis(uint64(m.Revision))
+	}
+	if m.AllowKvCallbacks {
+		n += 2
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoragePrefix)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextBlockHeader != nil {
+		l = m.NextBlockHeader.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DeliveryProof != nil {
+		l = m.DeliveryProof.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InclusionProof != nil {
+		l = m.InclusionProof.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastRegisteredQueryId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRegisteredQueryId))
+	}
+	if len(m.RegisteredQueries) > 0 {
+		for _, e := range m.RegisteredQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueryResults) > 0 {
+		for _, e := range m.QueryResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubmittedTransactions) > 0 {
+		for _, e := range m.SubmittedTransactions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueryResultHistory) > 0 {
+		for _, e := range m.QueryResultHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSudoFailureId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSudoFailureId))
+	}
+	if len(m.SudoFailures) > 0 {
+		for _, e := range m.SudoFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SoloMachineSequences) > 0 {
+		for _, e := range m.SoloMachineSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResultRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovGenesis(uint64(m.QueryId))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SudoFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	if m.QueryId != 0 {
+		n += 1 + sovGenesis(uint64(m.QueryId))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SubmittedTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovGenesis(uint64(m.QueryId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SoloMachineSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovGenesis(uint64(m.QueryId))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &KVKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionsFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+			}
+			m.UpdatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmittedResultLocalHeight", wireType)
+			}
+			m.LastSubmittedResultLocalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSubmittedResultLocalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeout", wireType)
+			}
+			m.SubmitTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAtHeight", wireType)
+			}
+			m.RegisteredAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmissionReward = append(m.SubmissionReward, types.Coin{})
+			if err := m.SubmissionReward[len(m.SubmissionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrow = append(m.RewardEscrow, types.Coin{})
+			if err := m.RewardEscrow[len(m.RewardEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultHistorySize", wireType)
+			}
+			m.ResultHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxMessagesFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxMessagesFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmittedResultRemoteHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSubmittedResultRemoteHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumedAtHeight", wireType)
+			}
+			m.ResumedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumedAtHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KvResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KvResults = append(m.KvResults, &StorageValue{})
+			if err := m.KvResults[len(m.KvResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowKvCallbacks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowKvCallbacks = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types2.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoragePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoragePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextBlockHeader == nil {
+				m.NextBlockHeader = &types2.Any{}
+			}
+			if err := m.NextBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &types2.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &TxValue{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &TxValue{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types3.ResponseDeliverTx{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeliveryProof == nil {
+				m.DeliveryProof = &crypto.Proof{}
+			}
+			if err := m.DeliveryProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusionProof == nil {
+				m.InclusionProof = &crypto.Proof{}
+			}
+			if err := m.InclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRegisteredQueryId", wireType)
+			}
+			m.LastRegisteredQueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRegisteredQueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredQueries = append(m.RegisteredQueries, RegisteredQuery{})
+			if err := m.RegisteredQueries[len(m.RegisteredQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryResults = append(m.QueryResults, QueryResultRecord{})
+			if err := m.QueryResults[len(m.QueryResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmittedTransactions = append(m.SubmittedTransactions, SubmittedTransaction{})
+			if err := m.SubmittedTransactions[len(m.SubmittedTransactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResultHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryResultHistory = append(m.QueryResultHistory, QueryResultRecord{})
+			if err := m.QueryResultHistory[len(m.QueryResultHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSudoFailureId", wireType)
+			}
+			m.LastSudoFailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSudoFailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoFailures = append(m.SudoFailures, SudoFailure{})
+			if err := m.SudoFailures[len(m.SudoFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoloMachineSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoloMachineSequences = append(m.SoloMachineSequences, SoloMachineSequence{})
+			if err := m.SoloMachineSequences[len(m.SoloMachineSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResultRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResultRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResultRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &QueryResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
+func (m *SudoFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -600,10 +4114,10 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: SudoFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SudoFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -626,10 +4140,10 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			var stringLen uint64
+			m.QueryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -639,27 +4153,14 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -687,13 +4188,13 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -703,29 +4204,29 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &KVKey{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -753,136 +4254,13 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionsFilter = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
-			}
-			m.UpdatePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatePeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmittedResultLocalHeight", wireType)
-			}
-			m.LastSubmittedResultLocalHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSubmittedResultLocalHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmittedResultRemoteHeight", wireType)
-			}
-			m.LastSubmittedResultRemoteHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastSubmittedResultRemoteHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposit = append(m.Deposit, types.Coin{})
-			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.SubmitTimeout = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -892,7 +4270,7 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitTimeout |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -918,7 +4296,7 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVKey) Unmarshal(dAtA []byte) error {
+func (m *SubmittedTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -941,17 +4319,17 @@ func (m *KVKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubmittedTransaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubmittedTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			var stringLen uint64
+			m.QueryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -961,27 +4339,14 @@ func (m *KVKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1008,9 +4373,9 @@ func (m *KVKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1034,7 +4399,7 @@ func (m *KVKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *SoloMachineSequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,17 +4422,36 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SoloMachineSequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SoloMachineSequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1077,25 +4461,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
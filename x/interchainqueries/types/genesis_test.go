@@ -21,9 +21,198 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
+			genState: &types.GenesisState{Params: types.DefaultParams()},
 			valid:    true,
 		},
+		{
+			desc:     "zero sudo call gas limit",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "zero tx query removal limit",
+			genState: &types.GenesisState{Params: func() types.Params {
+				params := types.DefaultParams()
+				params.TxQueryRemovalLimit = 0
+				return params
+			}()},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state with queries",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				LastRegisteredQueryId: 2,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+					{Id: 2, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeTX)},
+				},
+				QueryResults: []types.QueryResultRecord{
+					{QueryId: 1, Result: &types.QueryResult{Height: 1}},
+				},
+				SubmittedTransactions: []types.SubmittedTransaction{
+					{QueryId: 2, TxHash: []byte("hash")},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "query id is greater than last registered query id",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 2, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate query id",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid query owner",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: "invalid", QueryType: string(types.InterchainQueryTypeKV)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid query type",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: "invalid"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "query result for unknown query",
+			genState: &types.GenesisState{
+				QueryResults: []types.QueryResultRecord{
+					{QueryId: 1, Result: &types.QueryResult{Height: 1}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "query result for TX query",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeTX)},
+				},
+				QueryResults: []types.QueryResultRecord{
+					{QueryId: 1, Result: &types.QueryResult{Height: 1}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "submitted transaction for KV query",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+				},
+				SubmittedTransactions: []types.SubmittedTransaction{
+					{QueryId: 1, TxHash: []byte("hash")},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate submitted transaction",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeTX)},
+				},
+				SubmittedTransactions: []types.SubmittedTransaction{
+					{QueryId: 1, TxHash: []byte("hash")},
+					{QueryId: 1, TxHash: []byte("hash")},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate query result history record",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV), ResultHistorySize: 2},
+				},
+				QueryResultHistory: []types.QueryResultRecord{
+					{QueryId: 1, Result: &types.QueryResult{Height: 1}},
+					{QueryId: 1, Result: &types.QueryResult{Height: 1}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "sudo failure id greater than last sudo failure id",
+			genState: &types.GenesisState{
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+				},
+				LastSudoFailureId: 1,
+				SudoFailures: []types.SudoFailure{
+					{Id: 2, QueryId: 1, Contract: TestAddress},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "sudo failure for unknown query",
+			genState: &types.GenesisState{
+				LastSudoFailureId: 1,
+				SudoFailures: []types.SudoFailure{
+					{Id: 1, QueryId: 1, Contract: TestAddress},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "solo machine sequence for TX query",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeTX)},
+				},
+				SoloMachineSequences: []types.SoloMachineSequence{
+					{QueryId: 1, ClientId: "06-solomachine-0", Sequence: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate solo machine sequence",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				LastRegisteredQueryId: 1,
+				RegisteredQueries: []types.RegisteredQuery{
+					{Id: 1, Owner: TestAddress, QueryType: string(types.InterchainQueryTypeKV)},
+				},
+				SoloMachineSequences: []types.SoloMachineSequence{
+					{QueryId: 1, ClientId: "06-solomachine-0", Sequence: 1},
+					{QueryId: 1, ClientId: "06-solomachine-0", Sequence: 2},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
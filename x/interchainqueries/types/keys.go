@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -24,6 +27,15 @@ const (
 	prefixRegisteredQueryResult
 
 	prefixSubmittedTx
+	prefixTxQueryToRemove
+	prefixQueryResultHistory
+	prefixSudoFailure
+	prefixOwnerQueriesCount
+	prefixQueryByOwner
+	prefixQueryByConnection
+	prefixSuspendedConnection
+	prefixConnectionStatusUpdate
+	prefixSoloMachineSequence
 )
 
 var (
@@ -32,7 +44,30 @@ var (
 
 	SubmittedTxKey = []byte{prefixSubmittedTx}
 
+	TxQueryToRemoveKey = []byte{prefixTxQueryToRemove}
+
+	QueryResultHistoryKey = []byte{prefixQueryResultHistory}
+
+	SudoFailureKey = []byte{prefixSudoFailure}
+
+	OwnerQueriesCountKey = []byte{prefixOwnerQueriesCount}
+
+	QueryByOwnerKey      = []byte{prefixQueryByOwner}
+	QueryByConnectionKey = []byte{prefixQueryByConnection}
+
+	SuspendedConnectionKey = []byte{prefixSuspendedConnection}
+
+	ConnectionStatusUpdateKey = []byte{prefixConnectionStatusUpdate}
+
+	SoloMachineSequenceKey = []byte{prefixSoloMachineSequence}
+
 	LastRegisteredQueryIdKey = []byte{0x64}
+
+	LastExpiryCheckedQueryIdKey = []byte{0x65}
+
+	LastSudoFailureIdKey = []byte{0x66}
+
+	LastStatusCheckedConnectionKey = []byte{0x67}
 )
 
 func GetRegisteredQueryByIDKey(id uint64) []byte {
@@ -47,6 +82,66 @@ func GetSubmittedTransactionIDForQueryKey(queryID uint64, txHash []byte) []byte
 	return append(GetSubmittedTransactionIDForQueryKeyPrefix(queryID), txHash...)
 }
 
+func GetTxQueryToRemoveByIDKey(queryID uint64) []byte {
+	return append(TxQueryToRemoveKey, sdk.Uint64ToBigEndian(queryID)...)
+}
+
 func GetRegisteredQueryResultByIDKey(id uint64) []byte {
 	return append(RegisteredQueryResultKey, sdk.Uint64ToBigEndian(id)...)
 }
+
+func GetQueryResultHistoryKeyPrefix(queryID uint64) []byte {
+	return append(QueryResultHistoryKey, sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func GetQueryResultHistoryRevisionKeyPrefix(queryID uint64, revision uint64) []byte {
+	return append(GetQueryResultHistoryKeyPrefix(queryID), sdk.Uint64ToBigEndian(revision)...)
+}
+
+func GetQueryResultHistoryKey(queryID uint64, revision uint64, remoteHeight uint64) []byte {
+	return append(GetQueryResultHistoryRevisionKeyPrefix(queryID, revision), sdk.Uint64ToBigEndian(remoteHeight)...)
+}
+
+func GetSudoFailureKeyPrefix(queryID uint64) []byte {
+	return append(SudoFailureKey, sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func GetSudoFailureKey(queryID uint64, failureID uint64) []byte {
+	return append(GetSudoFailureKeyPrefix(queryID), sdk.Uint64ToBigEndian(failureID)...)
+}
+
+func GetOwnerQueriesCountKey(owner string) []byte {
+	return append(OwnerQueriesCountKey, []byte(owner)...)
+}
+
+func GetQueryByOwnerKeyPrefix(owner string) []byte {
+	return append(QueryByOwnerKey, address.MustLengthPrefix([]byte(owner))...)
+}
+
+func GetQueryByOwnerKey(owner string, queryID uint64) []byte {
+	return append(GetQueryByOwnerKeyPrefix(owner), sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func GetQueryByConnectionKeyPrefix(connectionID string) []byte {
+	return append(QueryByConnectionKey, address.MustLengthPrefix([]byte(connectionID))...)
+}
+
+func GetQueryByConnectionKey(connectionID string, queryID uint64) []byte {
+	return append(GetQueryByConnectionKeyPrefix(connectionID), sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func GetSuspendedConnectionKey(connectionID string) []byte {
+	return append(SuspendedConnectionKey, []byte(connectionID)...)
+}
+
+func GetConnectionStatusUpdateKey(connectionID string) []byte {
+	return append(ConnectionStatusUpdateKey, []byte(connectionID)...)
+}
+
+func GetSoloMachineSequenceKeyPrefix(queryID uint64) []byte {
+	return append(SoloMachineSequenceKey, sdk.Uint64ToBigEndian(queryID)...)
+}
+
+func GetSoloMachineSequenceKey(queryID uint64, clientID string) []byte {
+	return append(GetSoloMachineSequenceKeyPrefix(queryID), []byte(clientID)...)
+}
@@ -0,0 +1,217 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// The builders below return the KV keys of the well-known Cosmos SDK and IBC stores. The keys follow the
+// store layout of the Cosmos SDK v0.45 and ibc-go v3, so the remote chain is expected to use the same layout.
+// Addresses are accepted in bech32 encoding with any human-readable part, since the remote chain addresses
+// don't use the Neutron one.
+
+// NewBankBalanceKVKey returns the key of the balance of the address in the denom.
+func NewBankBalanceKVKey(address string, denom string) (KVKey, error) {
+	addr, err := addressFromBech32(address)
+	if err != nil {
+		return KVKey{}, err
+	}
+	if err := validateDenom(denom); err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: banktypes.StoreKey,
+		Key:  append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...),
+	}, nil
+}
+
+// NewBankSupplyKVKey returns the key of the total supply of the denom.
+func NewBankSupplyKVKey(denom string) (KVKey, error) {
+	if err := validateDenom(denom); err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: banktypes.StoreKey,
+		Key:  append(append([]byte{}, banktypes.SupplyKey...), []byte(denom)...),
+	}, nil
+}
+
+// NewStakingValidatorKVKey returns the key of the validator with the operator address.
+func NewStakingValidatorKVKey(validator string) (KVKey, error) {
+	valAddr, err := addressFromBech32(validator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: stakingtypes.StoreKey,
+		Key:  stakingtypes.GetValidatorKey(sdk.ValAddress(valAddr)),
+	}, nil
+}
+
+// NewStakingDelegationKVKey returns the key of the delegation of the delegator to the validator.
+func NewStakingDelegationKVKey(delegator string, validator string) (KVKey, error) {
+	delAddr, valAddr, err := delegatorValidatorFromBech32(delegator, validator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: stakingtypes.StoreKey,
+		Key:  stakingtypes.GetDelegationKey(delAddr, valAddr),
+	}, nil
+}
+
+// NewStakingUnbondingDelegationKVKey returns the key of the unbonding delegation of the delegator from the validator.
+func NewStakingUnbondingDelegationKVKey(delegator string, validator string) (KVKey, error) {
+	delAddr, valAddr, err := delegatorValidatorFromBech32(delegator, validator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: stakingtypes.StoreKey,
+		Key:  stakingtypes.GetUBDKey(delAddr, valAddr),
+	}, nil
+}
+
+// NewDistributionFeePoolKVKey returns the key of the distribution fee pool (community pool).
+func NewDistributionFeePoolKVKey() KVKey {
+	return KVKey{
+		Path: distrtypes.StoreKey,
+		Key:  append([]byte{}, distrtypes.FeePoolKey...),
+	}
+}
+
+// NewDistributionDelegatorWithdrawAddressKVKey returns the key of the rewards withdraw address of the delegator.
+func NewDistributionDelegatorWithdrawAddressKVKey(delegator string) (KVKey, error) {
+	delAddr, err := addressFromBech32(delegator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: distrtypes.StoreKey,
+		Key:  distrtypes.GetDelegatorWithdrawAddrKey(delAddr),
+	}, nil
+}
+
+// NewDistributionValidatorOutstandingRewardsKVKey returns the key of the outstanding rewards of the validator.
+func NewDistributionValidatorOutstandingRewardsKVKey(validator string) (KVKey, error) {
+	valAddr, err := addressFromBech32(validator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: distrtypes.StoreKey,
+		Key:  distrtypes.GetValidatorOutstandingRewardsKey(sdk.ValAddress(valAddr)),
+	}, nil
+}
+
+// NewDistributionDelegatorStartingInfoKVKey returns the key of the starting info of the delegation of the
+// delegator to the validator, which is used to calculate the delegation rewards.
+func NewDistributionDelegatorStartingInfoKVKey(delegator string, validator string) (KVKey, error) {
+	delAddr, valAddr, err := delegatorValidatorFromBech32(delegator, validator)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: distrtypes.StoreKey,
+		Key:  distrtypes.GetDelegatorStartingInfoKey(valAddr, delAddr),
+	}, nil
+}
+
+// NewGovProposalKVKey returns the key of the governance proposal.
+func NewGovProposalKVKey(proposalID uint64) KVKey {
+	return KVKey{
+		Path: govtypes.StoreKey,
+		Key:  govtypes.ProposalKey(proposalID),
+	}
+}
+
+// NewGovVoteKVKey returns the key of the vote of the voter on the governance proposal.
+func NewGovVoteKVKey(proposalID uint64, voter string) (KVKey, error) {
+	voterAddr, err := addressFromBech32(voter)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: govtypes.StoreKey,
+		Key:  govtypes.VoteKey(proposalID, voterAddr),
+	}, nil
+}
+
+// NewGovDepositKVKey returns the key of the deposit of the depositor to the governance proposal.
+func NewGovDepositKVKey(proposalID uint64, depositor string) (KVKey, error) {
+	depositorAddr, err := addressFromBech32(depositor)
+	if err != nil {
+		return KVKey{}, err
+	}
+
+	return KVKey{
+		Path: govtypes.StoreKey,
+		Key:  govtypes.DepositKey(proposalID, depositorAddr),
+	}, nil
+}
+
+// NewIBCTransferDenomTraceKVKey returns the key of the denom trace of the IBC denom. Both the hex hash of
+// the trace and the full IBC denom (i.e. 'ibc/{hash}') are accepted.
+func NewIBCTransferDenomTraceKVKey(denom string) (KVKey, error) {
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return KVKey{}, sdkerrors.Wrapf(ErrInvalidKVKeyParams, "invalid denom trace hash %s: %v", denom, err)
+	}
+
+	return KVKey{
+		Path: ibctransfertypes.StoreKey,
+		Key:  append(append([]byte{}, ibctransfertypes.DenomTraceKey...), hash...),
+	}, nil
+}
+
+// addressFromBech32 decodes the bech32 address regardless of its human-readable part.
+func addressFromBech32(address string) (sdk.AccAddress, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to decode address from bech32 (%s): %v", address, err)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %v", address, err)
+	}
+
+	return bz, nil
+}
+
+func delegatorValidatorFromBech32(delegator string, validator string) (sdk.AccAddress, sdk.ValAddress, error) {
+	delAddr, err := addressFromBech32(delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valAddr, err := addressFromBech32(validator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delAddr, sdk.ValAddress(valAddr), nil
+}
+
+func validateDenom(denom string) error {
+	if strings.TrimSpace(denom) == "" {
+		return sdkerrors.Wrap(ErrInvalidKVKeyParams, "denom can't be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func TestKVKeyBuilders(t *testing.T) {
+	addr := sdk.AccAddress([]byte("delegator_address___"))
+	valAddr := sdk.AccAddress([]byte("validator_address___"))
+
+	// remote chain addresses have their own human-readable parts
+	delegator, err := bech32.ConvertAndEncode("cosmos", addr)
+	require.NoError(t, err)
+	validator, err := bech32.ConvertAndEncode("cosmosvaloper", valAddr)
+	require.NoError(t, err)
+
+	lengthPrefixed := func(prefix []byte, bz ...[]byte) []byte {
+		key := append([]byte{}, prefix...)
+		for _, b := range bz {
+			key = append(key, byte(len(b)))
+			key = append(key, b...)
+		}
+		return key
+	}
+
+	testCases := []struct {
+		name     string
+		build    func() (iqtypes.KVKey, error)
+		expected iqtypes.KVKey
+	}{
+		{
+			"bank balance",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewBankBalanceKVKey(delegator, "uatom") },
+			iqtypes.KVKey{Path: "bank", Key: append(lengthPrefixed([]byte{0x02}, addr), []byte("uatom")...)},
+		},
+		{
+			"bank supply",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewBankSupplyKVKey("uatom") },
+			iqtypes.KVKey{Path: "bank", Key: append([]byte{0x00}, []byte("uatom")...)},
+		},
+		{
+			"staking validator",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewStakingValidatorKVKey(validator) },
+			iqtypes.KVKey{Path: "staking", Key: lengthPrefixed([]byte{0x21}, valAddr)},
+		},
+		{
+			"staking delegation",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewStakingDelegationKVKey(delegator, validator) },
+			iqtypes.KVKey{Path: "staking", Key: lengthPrefixed([]byte{0x31}, addr, valAddr)},
+		},
+		{
+			"staking unbonding delegation",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewStakingUnbondingDelegationKVKey(delegator, validator)
+			},
+			iqtypes.KVKey{Path: "staking", Key: lengthPrefixed([]byte{0x32}, addr, valAddr)},
+		},
+		{
+			"distribution fee pool",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewDistributionFeePoolKVKey(), nil },
+			iqtypes.KVKey{Path: "distribution", Key: []byte{0x00}},
+		},
+		{
+			"distribution delegator withdraw address",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewDistributionDelegatorWithdrawAddressKVKey(delegator)
+			},
+			iqtypes.KVKey{Path: "distribution", Key: lengthPrefixed([]byte{0x03}, addr)},
+		},
+		{
+			"distribution validator outstanding rewards",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewDistributionValidatorOutstandingRewardsKVKey(validator)
+			},
+			iqtypes.KVKey{Path: "distribution", Key: lengthPrefixed([]byte{0x02}, valAddr)},
+		},
+		{
+			"distribution delegator starting info",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewDistributionDelegatorStartingInfoKVKey(delegator, validator)
+			},
+			iqtypes.KVKey{Path: "distribution", Key: lengthPrefixed([]byte{0x04}, valAddr, addr)},
+		},
+		{
+			"gov proposal",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewGovProposalKVKey(7), nil },
+			iqtypes.KVKey{Path: "gov", Key: append([]byte{0x00}, sdk.Uint64ToBigEndian(7)...)},
+		},
+		{
+			"gov vote",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewGovVoteKVKey(7, delegator) },
+			iqtypes.KVKey{Path: "gov", Key: lengthPrefixed(append([]byte{0x20}, sdk.Uint64ToBigEndian(7)...), addr)},
+		},
+		{
+			"gov deposit",
+			func() (iqtypes.KVKey, error) { return iqtypes.NewGovDepositKVKey(7, delegator) },
+			iqtypes.KVKey{Path: "gov", Key: lengthPrefixed(append([]byte{0x10}, sdk.Uint64ToBigEndian(7)...), addr)},
+		},
+		{
+			"ibc transfer denom trace by hash",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewIBCTransferDenomTraceKVKey("27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+			},
+			iqtypes.KVKey{Path: "transfer", Key: append([]byte{0x02}, mustDecodeHex(t, "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")...)},
+		},
+		{
+			"ibc transfer denom trace by denom",
+			func() (iqtypes.KVKey, error) {
+				return iqtypes.NewIBCTransferDenomTraceKVKey("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+			},
+			iqtypes.KVKey{Path: "transfer", Key: append([]byte{0x02}, mustDecodeHex(t, "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")...)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := tc.build()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, key)
+		})
+	}
+
+	_, err = iqtypes.NewBankBalanceKVKey("not an address", "uatom")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = iqtypes.NewBankSupplyKVKey("")
+	require.ErrorIs(t, err, iqtypes.ErrInvalidKVKeyParams)
+
+	_, err = iqtypes.NewIBCTransferDenomTraceKVKey("ibc/not a hash")
+	require.ErrorIs(t, err, iqtypes.ErrInvalidKVKeyParams)
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// SupplyValueType is the type name of the total supply values, which are stored as bare integers.
+const SupplyValueType = "cosmos.Int"
+
+// kvValueDecoder decodes the values stored under the keys with the keyPrefix in the storeKey store. The
+// store layouts are the same the typed KV key builders follow.
+type kvValueDecoder struct {
+	storeKey  string
+	keyPrefix []byte
+	decode    func(cdc codec.Codec, bz []byte) (valueType string, decoded []byte, err error)
+}
+
+var kvValueDecoders = []kvValueDecoder{
+	{banktypes.StoreKey, banktypes.BalancesPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &sdk.Coin{} })},
+	{banktypes.StoreKey, banktypes.SupplyKey, decodeSupplyValue},
+	{stakingtypes.StoreKey, stakingtypes.ValidatorsKey, protoValueDecoder(func() codec.ProtoMarshaler { return &stakingtypes.Validator{} })},
+	{stakingtypes.StoreKey, stakingtypes.DelegationKey, protoValueDecoder(func() codec.ProtoMarshaler { return &stakingtypes.Delegation{} })},
+	{stakingtypes.StoreKey, stakingtypes.UnbondingDelegationKey, protoValueDecoder(func() codec.ProtoMarshaler { return &stakingtypes.UnbondingDelegation{} })},
+	{distrtypes.StoreKey, distrtypes.FeePoolKey, protoValueDecoder(func() codec.ProtoMarshaler { return &distrtypes.FeePool{} })},
+	{distrtypes.StoreKey, distrtypes.ValidatorOutstandingRewardsPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &distrtypes.ValidatorOutstandingRewards{} })},
+	{distrtypes.StoreKey, distrtypes.DelegatorStartingInfoPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &distrtypes.DelegatorStartingInfo{} })},
+	{govtypes.StoreKey, govtypes.ProposalsKeyPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &govtypes.Proposal{} })},
+	{govtypes.StoreKey, govtypes.VotesKeyPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &govtypes.Vote{} })},
+	{govtypes.StoreKey, govtypes.DepositsKeyPrefix, protoValueDecoder(func() codec.ProtoMarshaler { return &govtypes.Deposit{} })},
+	{ibctransfertypes.StoreKey, ibctransfertypes.DenomTraceKey, protoValueDecoder(func() codec.ProtoMarshaler { return &ibctransfertypes.DenomTrace{} })},
+}
+
+// DecodeStorageValue decodes the value of the well-known store key into JSON. Values of unknown keys,
+// values failed to be decoded and absent values are returned raw.
+func DecodeStorageValue(cdc codec.Codec, value StorageValue) DecodedStorageValue {
+	decodedValue := DecodedStorageValue{
+		StoragePrefix: value.StoragePrefix,
+		Key:           value.Key,
+		Value:         value.Value,
+	}
+	if len(value.Value) == 0 {
+		return decodedValue
+	}
+
+	for _, decoder := range kvValueDecoders {
+		if decoder.storeKey != value.StoragePrefix || !bytes.HasPrefix(value.Key, decoder.keyPrefix) {
+			continue
+		}
+
+		valueType, decoded, err := decoder.decode(cdc, value.Value)
+		if err != nil {
+			return decodedValue
+		}
+
+		decodedValue.Type = valueType
+		decodedValue.DecodedJson = string(decoded)
+		decodedValue.Value = nil
+		return decodedValue
+	}
+
+	return decodedValue
+}
+
+func protoValueDecoder(newValue func() codec.ProtoMarshaler) func(codec.Codec, []byte) (string, []byte, error) {
+	return func(cdc codec.Codec, bz []byte) (string, []byte, error) {
+		value := newValue()
+		if err := cdc.Unmarshal(bz, value); err != nil {
+			return "", nil, err
+		}
+
+		decoded, err := cdc.MarshalJSON(value)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return proto.MessageName(value), decoded, nil
+	}
+}
+
+func decodeSupplyValue(_ codec.Codec, bz []byte) (string, []byte, error) {
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return "", nil, err
+	}
+
+	decoded, err := json.Marshal(amount)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return SupplyValueType, decoded, nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func TestDecodeStorageValue(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	delegator, err := bech32.ConvertAndEncode("cosmos", []byte("delegator_address___"))
+	require.NoError(t, err)
+	validator, err := bech32.ConvertAndEncode("cosmosvaloper", []byte("validator_address___"))
+	require.NoError(t, err)
+
+	balanceKey, err := iqtypes.NewBankBalanceKVKey(delegator, "uatom")
+	require.NoError(t, err)
+	balance := sdk.NewCoin("uatom", sdk.NewInt(100))
+
+	supplyKey, err := iqtypes.NewBankSupplyKVKey("uatom")
+	require.NoError(t, err)
+	supply, err := sdk.NewInt(1000).Marshal()
+	require.NoError(t, err)
+
+	delegationKey, err := iqtypes.NewStakingDelegationKVKey(delegator, validator)
+	require.NoError(t, err)
+	delegation := stakingtypes.Delegation{DelegatorAddress: delegator, ValidatorAddress: validator, Shares: sdk.NewDec(5)}
+
+	testCases := []struct {
+		name     string
+		value    iqtypes.StorageValue
+		expected iqtypes.DecodedStorageValue
+	}{
+		{
+			"bank balance",
+			iqtypes.StorageValue{StoragePrefix: balanceKey.Path, Key: balanceKey.Key, Value: cdc.MustMarshal(&balance)},
+			iqtypes.DecodedStorageValue{
+				StoragePrefix: balanceKey.Path,
+				Key:           balanceKey.Key,
+				Type:          "cosmos.base.v1beta1.Coin",
+				DecodedJson:   `{"denom":"uatom","amount":"100"}`,
+			},
+		},
+		{
+			"bank supply",
+			iqtypes.StorageValue{StoragePrefix: supplyKey.Path, Key: supplyKey.Key, Value: supply},
+			iqtypes.DecodedStorageValue{
+				StoragePrefix: supplyKey.Path,
+				Key:           supplyKey.Key,
+				Type:          iqtypes.SupplyValueType,
+				DecodedJson:   `"1000"`,
+			},
+		},
+		{
+			"staking delegation",
+			iqtypes.StorageValue{StoragePrefix: delegationKey.Path, Key: delegationKey.Key, Value: cdc.MustMarshal(&delegation)},
+			iqtypes.DecodedStorageValue{
+				StoragePrefix: delegationKey.Path,
+				Key:           delegationKey.Key,
+				Type:          "cosmos.staking.v1beta1.Delegation",
+				DecodedJson:   `{"delegator_address":"` + delegator + `","validator_address":"` + validator + `","shares":"5.000000000000000000"}`,
+			},
+		},
+		{
+			"unknown key",
+			iqtypes.StorageValue{StoragePrefix: "ibc", Key: []byte("clients"), Value: []byte("value")},
+			iqtypes.DecodedStorageValue{StoragePrefix: "ibc", Key: []byte("clients"), Value: []byte("value")},
+		},
+		{
+			"undecodable value",
+			iqtypes.StorageValue{StoragePrefix: balanceKey.Path, Key: balanceKey.Key, Value: []byte{0xff}},
+			iqtypes.DecodedStorageValue{StoragePrefix: balanceKey.Path, Key: balanceKey.Key, Value: []byte{0xff}},
+		},
+		{
+			"absent value",
+			iqtypes.StorageValue{StoragePrefix: balanceKey.Path, Key: balanceKey.Key},
+			iqtypes.DecodedStorageValue{StoragePrefix: balanceKey.Path, Key: balanceKey.Key},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, iqtypes.DecodeStorageValue(cdc, tc.value))
+		})
+	}
+}
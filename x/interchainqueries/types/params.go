@@ -11,10 +11,44 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyQuerySubmitTimeout               = []byte("QuerySubmitTimeout")
-	DefaultQuerySubmitTimeout           = uint64(518400) // One month, with block_time = 5s
-	KeyQueryDeposit                     = []byte("QueryDeposit")
-	DefaultQueryDeposit       sdk.Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(1_000_000))))
+	KeyQuerySubmitTimeout                        = []byte("QuerySubmitTimeout")
+	DefaultQuerySubmitTimeout                    = uint64(518400) // One month, with block_time = 5s
+	KeyQueryDeposit                              = []byte("QueryDeposit")
+	DefaultQueryDeposit                sdk.Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(1_000_000))))
+	KeyQueryExpiryPeriod                         = []byte("QueryExpiryPeriod")
+	DefaultQueryExpiryPeriod                     = uint64(1_555_200) // Three months, with block_time = 5s
+	KeyBurnExpiredQueryDeposit                   = []byte("BurnExpiredQueryDeposit")
+	DefaultBurnExpiredQueryDeposit               = false
+	KeyExpiryChecksPerBlock                      = []byte("ExpiryChecksPerBlock")
+	DefaultExpiryChecksPerBlock                  = uint64(100)
+	KeyTxQueryRemovalLimit                       = []byte("TxQueryRemovalLimit")
+	DefaultTxQueryRemovalLimit                   = uint64(10_000)
+	KeyMaxResultHistorySize                      = []byte("MaxResultHistorySize")
+	DefaultMaxResultHistorySize                  = uint64(100)
+	KeySudoCallGasLimit                          = []byte("SudoCallGasLimit")
+	DefaultSudoCallGasLimit                      = uint64(1_000_000)
+	KeyMaxKvQueryKeysCount                       = []byte("MaxKvQueryKeysCount")
+	DefaultMaxKvQueryKeysCount                   = uint64(32)
+	KeyMaxActiveQueriesPerOwner                  = []byte("MaxActiveQueriesPerOwner")
+	DefaultMaxActiveQueriesPerOwner              = uint64(100)
+	KeyMaxTransactionsFilterLength               = []byte("MaxTransactionsFilterLength")
+	DefaultMaxTransactionsFilterLength           = uint64(4096)
+	KeyQueryDepositPerKey                        = []byte("QueryDepositPerKey")
+	DefaultQueryDepositPerKey          sdk.Coins = nil
+	KeyTxQueryDepositSurcharge                   = []byte("TxQueryDepositSurcharge")
+	DefaultTxQueryDepositSurcharge     sdk.Coins = nil
+	KeyClientStatusChecksPerBlock                = []byte("ClientStatusChecksPerBlock")
+	DefaultClientStatusChecksPerBlock            = uint64(100)
+)
+
+const (
+	// MaxKVQueryKeysCountLimit is the max amount of keys a KV query can have regardless of the params.
+	MaxKVQueryKeysCountLimit = 1024
+	// MaxTransactionsFilterLengthLimit is the max length of a TX query filter regardless of the params.
+	MaxTransactionsFilterLengthLimit = 64 * 1024
+	// MaxSudoCallGasLimit is the max amount of gas a sudo call of a query owner contract can consume
+	// regardless of the params.
+	MaxSudoCallGasLimit = 100_000_000
 )
 
 // ParamKeyTable the param key table for launch module
@@ -22,20 +56,74 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(KeyQuerySubmitTimeout, DefaultQuerySubmitTimeout, func(value interface{}) error { return nil }),
 		paramtypes.NewParamSetPair(KeyQueryDeposit, sdk.Coins{}, validateCoins),
+		paramtypes.NewParamSetPair(KeyQueryExpiryPeriod, DefaultQueryExpiryPeriod, validateUint64),
+		paramtypes.NewParamSetPair(KeyBurnExpiredQueryDeposit, DefaultBurnExpiredQueryDeposit, validateBool),
+		paramtypes.NewParamSetPair(KeyExpiryChecksPerBlock, DefaultExpiryChecksPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyTxQueryRemovalLimit, DefaultTxQueryRemovalLimit, validateTxQueryRemovalLimit),
+		paramtypes.NewParamSetPair(KeyMaxResultHistorySize, DefaultMaxResultHistorySize, validateUint64),
+		paramtypes.NewParamSetPair(KeySudoCallGasLimit, DefaultSudoCallGasLimit, validateSudoCallGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxKvQueryKeysCount, DefaultMaxKvQueryKeysCount, validateMaxKvQueryKeysCount),
+		paramtypes.NewParamSetPair(KeyMaxActiveQueriesPerOwner, DefaultMaxActiveQueriesPerOwner, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxTransactionsFilterLength, DefaultMaxTransactionsFilterLength, validateMaxTransactionsFilterLength),
+		paramtypes.NewParamSetPair(KeyQueryDepositPerKey, sdk.Coins{}, validateCoins),
+		paramtypes.NewParamSetPair(KeyTxQueryDepositSurcharge, sdk.Coins{}, validateCoins),
+		paramtypes.NewParamSetPair(KeyClientStatusChecksPerBlock, DefaultClientStatusChecksPerBlock, validateUint64),
 	)
 }
 
 // NewParams creates a new Params instance
-func NewParams(querySubmitTimeout uint64, queryDeposit sdk.Coins) Params {
+func NewParams(
+	querySubmitTimeout uint64,
+	queryDeposit sdk.Coins,
+	queryExpiryPeriod uint64,
+	burnExpiredQueryDeposit bool,
+	expiryChecksPerBlock uint64,
+	txQueryRemovalLimit uint64,
+	maxResultHistorySize uint64,
+	sudoCallGasLimit uint64,
+	maxKvQueryKeysCount uint64,
+	maxActiveQueriesPerOwner uint64,
+	maxTransactionsFilterLength uint64,
+	queryDepositPerKey sdk.Coins,
+	txQueryDepositSurcharge sdk.Coins,
+	clientStatusChecksPerBlock uint64,
+) Params {
 	return Params{
-		QuerySubmitTimeout: querySubmitTimeout,
-		QueryDeposit:       queryDeposit,
+		QuerySubmitTimeout:          querySubmitTimeout,
+		QueryDeposit:                queryDeposit,
+		QueryExpiryPeriod:           queryExpiryPeriod,
+		BurnExpiredQueryDeposit:     burnExpiredQueryDeposit,
+		ExpiryChecksPerBlock:        expiryChecksPerBlock,
+		TxQueryRemovalLimit:         txQueryRemovalLimit,
+		MaxResultHistorySize:        maxResultHistorySize,
+		SudoCallGasLimit:            sudoCallGasLimit,
+		MaxKvQueryKeysCount:         maxKvQueryKeysCount,
+		MaxActiveQueriesPerOwner:    maxActiveQueriesPerOwner,
+		MaxTransactionsFilterLength: maxTransactionsFilterLength,
+		QueryDepositPerKey:          queryDepositPerKey,
+		TxQueryDepositSurcharge:     txQueryDepositSurcharge,
+		ClientStatusChecksPerBlock:  clientStatusChecksPerBlock,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultQuerySubmitTimeout, DefaultQueryDeposit)
+	return NewParams(
+		DefaultQuerySubmitTimeout,
+		DefaultQueryDeposit,
+		DefaultQueryExpiryPeriod,
+		DefaultBurnExpiredQueryDeposit,
+		DefaultExpiryChecksPerBlock,
+		DefaultTxQueryRemovalLimit,
+		DefaultMaxResultHistorySize,
+		DefaultSudoCallGasLimit,
+		DefaultMaxKvQueryKeysCount,
+		DefaultMaxActiveQueriesPerOwner,
+		DefaultMaxTransactionsFilterLength,
+		DefaultQueryDepositPerKey,
+		DefaultTxQueryDepositSurcharge,
+		DefaultClientStatusChecksPerBlock,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -43,12 +131,50 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyQuerySubmitTimeout, &p.QuerySubmitTimeout, func(value interface{}) error { return nil }),
 		paramtypes.NewParamSetPair(KeyQueryDeposit, &p.QueryDeposit, validateCoins),
+		paramtypes.NewParamSetPair(KeyQueryExpiryPeriod, &p.QueryExpiryPeriod, validateUint64),
+		paramtypes.NewParamSetPair(KeyBurnExpiredQueryDeposit, &p.BurnExpiredQueryDeposit, validateBool),
+		paramtypes.NewParamSetPair(KeyExpiryChecksPerBlock, &p.ExpiryChecksPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyTxQueryRemovalLimit, &p.TxQueryRemovalLimit, validateTxQueryRemovalLimit),
+		paramtypes.NewParamSetPair(KeyMaxResultHistorySize, &p.MaxResultHistorySize, validateUint64),
+		paramtypes.NewParamSetPair(KeySudoCallGasLimit, &p.SudoCallGasLimit, validateSudoCallGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxKvQueryKeysCount, &p.MaxKvQueryKeysCount, validateMaxKvQueryKeysCount),
+		paramtypes.NewParamSetPair(KeyMaxActiveQueriesPerOwner, &p.MaxActiveQueriesPerOwner, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxTransactionsFilterLength, &p.MaxTransactionsFilterLength, validateMaxTransactionsFilterLength),
+		paramtypes.NewParamSetPair(KeyQueryDepositPerKey, &p.QueryDepositPerKey, validateCoins),
+		paramtypes.NewParamSetPair(KeyTxQueryDepositSurcharge, &p.TxQueryDepositSurcharge, validateCoins),
+		paramtypes.NewParamSetPair(KeyClientStatusChecksPerBlock, &p.ClientStatusChecksPerBlock, validateUint64),
 	}
 }
 
+// QueryDepositFor returns the deposit collected for a query of the type with the amount of keys: the base
+// query deposit plus the deposit per key for each key of a KV query or the surcharge for a TX query.
+func (p Params) QueryDepositFor(queryType InterchainQueryType, keysCount uint64) sdk.Coins {
+	deposit := sdk.NewCoins(p.QueryDeposit...)
+	if queryType.IsTX() {
+		return deposit.Add(p.TxQueryDepositSurcharge...)
+	}
+
+	for _, coin := range p.QueryDepositPerKey {
+		deposit = deposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(keysCount))))
+	}
+	return deposit
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateSudoCallGasLimit(p.SudoCallGasLimit); err != nil {
+		return err
+	}
+
+	if err := validateTxQueryRemovalLimit(p.TxQueryRemovalLimit); err != nil {
+		return err
+	}
+
+	if err := validateMaxKvQueryKeysCount(p.MaxKvQueryKeysCount); err != nil {
+		return err
+	}
+
+	return validateMaxTransactionsFilterLength(p.MaxTransactionsFilterLength)
 }
 
 // String implements the Stringer interface.
@@ -69,3 +195,75 @@ func validateCoins(i interface{}) error {
 
 	return nil
 }
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSudoCallGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("sudo call gas limit must be positive")
+	}
+
+	if v > MaxSudoCallGasLimit {
+		return fmt.Errorf("sudo call gas limit %d exceeds the limit %d", v, MaxSudoCallGasLimit)
+	}
+
+	return nil
+}
+
+func validateTxQueryRemovalLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("tx query removal limit must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxKvQueryKeysCount(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxKVQueryKeysCountLimit {
+		return fmt.Errorf("max kv query keys count %d exceeds the limit %d", v, MaxKVQueryKeysCountLimit)
+	}
+
+	return nil
+}
+
+func validateMaxTransactionsFilterLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTransactionsFilterLengthLimit {
+		return fmt.Errorf("max transactions filter length %d exceeds the limit %d", v, MaxTransactionsFilterLengthLimit)
+	}
+
+	return nil
+}
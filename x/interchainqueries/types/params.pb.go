@@ -31,6 +31,37 @@ type Params struct {
 	QuerySubmitTimeout uint64 `protobuf:"varint,1,opt,name=query_submit_timeout,json=querySubmitTimeout,proto3" json:"query_submit_timeout,omitempty"`
 	// Amount of coins deposited for the query.
 	QueryDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=query_deposit,json=queryDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"query_deposit"`
+	// Defines amount of blocks without submitted results after which a query is considered abandoned
+	// and is removed in EndBlock. Zero value disables removal of abandoned queries.
+	QueryExpiryPeriod uint64 `protobuf:"varint,3,opt,name=query_expiry_period,json=queryExpiryPeriod,proto3" json:"query_expiry_period,omitempty"`
+	// Defines whether the deposit of an expired query is burnt instead of being returned to the query owner.
+	BurnExpiredQueryDeposit bool `protobuf:"varint,4,opt,name=burn_expired_query_deposit,json=burnExpiredQueryDeposit,proto3" json:"burn_expired_query_deposit,omitempty"`
+	// Defines max amount of registered queries checked for expiration in a single EndBlock.
+	ExpiryChecksPerBlock uint64 `protobuf:"varint,5,opt,name=expiry_checks_per_block,json=expiryChecksPerBlock,proto3" json:"expiry_checks_per_block,omitempty"`
+	// Defines max amount of processed transaction hashes of removed TX queries deleted in a single EndBlock.
+	// Must be positive.
+	TxQueryRemovalLimit uint64 `protobuf:"varint,6,opt,name=tx_query_removal_limit,json=txQueryRemovalLimit,proto3" json:"tx_query_removal_limit,omitempty"`
+	// Defines max amount of the last submitted results a KV query can keep in its result history.
+	MaxResultHistorySize uint64 `protobuf:"varint,7,opt,name=max_result_history_size,json=maxResultHistorySize,proto3" json:"max_result_history_size,omitempty"`
+	// Defines max amount of gas a query owner contract can spend processing a submitted query result in its
+	// sudo handler. The result is saved even if the contract fails to process it.
+	SudoCallGasLimit uint64 `protobuf:"varint,8,opt,name=sudo_call_gas_limit,json=sudoCallGasLimit,proto3" json:"sudo_call_gas_limit,omitempty"`
+	// Defines max amount of keys a KV query can have. Zero value means no limit.
+	MaxKvQueryKeysCount uint64 `protobuf:"varint,9,opt,name=max_kv_query_keys_count,json=maxKvQueryKeysCount,proto3" json:"max_kv_query_keys_count,omitempty"`
+	// Defines max amount of queries a single owner can have registered at the same time. Zero value means no limit.
+	MaxActiveQueriesPerOwner uint64 `protobuf:"varint,10,opt,name=max_active_queries_per_owner,json=maxActiveQueriesPerOwner,proto3" json:"max_active_queries_per_owner,omitempty"`
+	// Defines max length in bytes of the transactions filter and the transaction messages filter of a TX query.
+	// Zero value means no limit.
+	MaxTransactionsFilterLength uint64 `protobuf:"varint,11,opt,name=max_transactions_filter_length,json=maxTransactionsFilterLength,proto3" json:"max_transactions_filter_length,omitempty"`
+	// Amount of coins deposited for each key of a KV query on top of the query_deposit.
+	QueryDepositPerKey github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=query_deposit_per_key,json=queryDepositPerKey,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"query_deposit_per_key"`
+	// Amount of coins deposited for a TX query on top of the query_deposit.
+	TxQueryDepositSurcharge github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=tx_query_deposit_surcharge,json=txQueryDepositSurcharge,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tx_query_deposit_surcharge"`
+	// Defines max amount of connections with registered queries which IBC client status is checked in a single
+	// BeginBlock to suspend or resume the queries, each query of a connection which client status has changed
+	// counts as a check as well.
+	// Zero value disables the checks.
+	ClientStatusChecksPerBlock uint64 `protobuf:"varint,14,opt,name=client_status_checks_per_block,json=clientStatusChecksPerBlock,proto3" json:"client_status_checks_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,6 +110,90 @@ func (m *Params) GetQueryDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetQueryExpiryPeriod() uint64 {
+	if m != nil {
+		return m.QueryExpiryPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetBurnExpiredQueryDeposit() bool {
+	if m != nil {
+		return m.BurnExpiredQueryDeposit
+	}
+	return false
+}
+
+func (m *Params) GetExpiryChecksPerBlock() uint64 {
+	if m != nil {
+		return m.ExpiryChecksPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTxQueryRemovalLimit() uint64 {
+	if m != nil {
+		return m.TxQueryRemovalLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxResultHistorySize() uint64 {
+	if m != nil {
+		return m.MaxResultHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetSudoCallGasLimit() uint64 {
+	if m != nil {
+		return m.SudoCallGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxKvQueryKeysCount() uint64 {
+	if m != nil {
+		return m.MaxKvQueryKeysCount
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveQueriesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxActiveQueriesPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTransactionsFilterLength() uint64 {
+	if m != nil {
+		return m.MaxTransactionsFilterLength
+	}
+	return 0
+}
+
+func (m *Params) GetQueryDepositPerKey() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QueryDepositPerKey
+	}
+	return nil
+}
+
+func (m *Params) GetTxQueryDepositSurcharge() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TxQueryDepositSurcharge
+	}
+	return nil
+}
+
+func (m *Params) GetClientStatusChecksPerBlock() uint64 {
+	if m != nil {
+		return m.ClientStatusChecksPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.interchainadapter.interchainqueries.Params")
 }
@@ -86,26 +201,48 @@ func init() {
 func init() { proto.RegisterFile("interchainqueries/params.proto", fileDescriptor_1421c1e223ed164f) }
 
 var fileDescriptor_1421c1e223ed164f = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x1c, 0xc4, 0xe3, 0xef, 0xab, 0x3a, 0x04, 0x58, 0xa2, 0x0e, 0xa5, 0x83, 0x5b, 0x31, 0x45, 0x42,
-	0xb5, 0x5b, 0x58, 0x10, 0x63, 0xe1, 0x01, 0x50, 0x60, 0x62, 0xa9, 0x9c, 0xc4, 0x4a, 0x2d, 0x14,
-	0xff, 0x8d, 0xed, 0x20, 0xfa, 0x16, 0x8c, 0x8c, 0xcc, 0x8c, 0x3c, 0x45, 0xc7, 0x8e, 0x4c, 0x80,
-	0x92, 0x17, 0x41, 0xb1, 0x83, 0x40, 0xea, 0xe4, 0x93, 0xce, 0x77, 0xbf, 0xbf, 0x2e, 0xc4, 0x42,
-	0x5a, 0xae, 0xb3, 0x15, 0x13, 0xf2, 0xbe, 0xe2, 0x5a, 0x70, 0x43, 0x15, 0xd3, 0xac, 0x34, 0x44,
-	0x69, 0xb0, 0x10, 0x1d, 0x4b, 0x5e, 0x59, 0x0d, 0x92, 0xfc, 0xfe, 0x63, 0x39, 0x53, 0x96, 0x6b,
-	0xb2, 0x93, 0x1c, 0x0d, 0x0a, 0x28, 0xc0, 0xe5, 0x68, 0xab, 0x7c, 0xc5, 0x08, 0x67, 0x60, 0x4a,
-	0x30, 0x34, 0x65, 0x86, 0xd3, 0x87, 0x79, 0xca, 0x2d, 0x9b, 0xd3, 0x0c, 0x84, 0xf4, 0xfe, 0xd1,
-	0x1b, 0x0a, 0xfb, 0x57, 0x8e, 0x19, 0xcd, 0xc2, 0x41, 0xdb, 0xb5, 0x5e, 0x9a, 0x2a, 0x2d, 0x85,
-	0x5d, 0x5a, 0x51, 0x72, 0xa8, 0xec, 0x10, 0x4d, 0x50, 0xdc, 0x4b, 0x22, 0xe7, 0x5d, 0x3b, 0xeb,
-	0xc6, 0x3b, 0x91, 0x0a, 0x0f, 0x7c, 0x22, 0xe7, 0x0a, 0x8c, 0xb0, 0xc3, 0x7f, 0x93, 0xff, 0xf1,
-	0xde, 0xc9, 0x21, 0xf1, 0x50, 0xd2, 0x42, 0x49, 0x07, 0x25, 0x17, 0x20, 0xe4, 0x62, 0xb6, 0xf9,
-	0x18, 0x07, 0xaf, 0x9f, 0xe3, 0xb8, 0x10, 0x76, 0x55, 0xa5, 0x24, 0x83, 0x92, 0x76, 0x17, 0xfa,
-	0x67, 0x6a, 0xf2, 0x3b, 0x6a, 0xd7, 0x8a, 0x1b, 0x17, 0x30, 0xc9, 0xbe, 0x23, 0x5c, 0x7a, 0xc0,
-	0x79, 0xef, 0xf9, 0x65, 0x1c, 0x2c, 0x92, 0x4d, 0x8d, 0xd1, 0xb6, 0xc6, 0xe8, 0xab, 0xc6, 0xe8,
-	0xa9, 0xc1, 0xc1, 0xb6, 0xc1, 0xc1, 0x7b, 0x83, 0x83, 0xdb, 0xb3, 0x3f, 0xbd, 0xdd, 0x78, 0x53,
-	0xd0, 0xc5, 0x8f, 0xa6, 0x8f, 0x74, 0x77, 0x72, 0x47, 0x4b, 0xfb, 0x6e, 0x8f, 0xd3, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xea, 0x48, 0x87, 0x94, 0x01, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x4f, 0x13, 0x4d,
+	0x18, 0xc6, 0xdb, 0x0f, 0x3e, 0xe0, 0x1b, 0xe0, 0x8b, 0x0e, 0x28, 0x6b, 0x35, 0x0b, 0xf1, 0xd4,
+	0xc4, 0xb0, 0x0b, 0xa2, 0x89, 0xd1, 0xc4, 0xc4, 0x56, 0xd4, 0x04, 0x12, 0x4b, 0xcb, 0xc9, 0xcb,
+	0x64, 0xba, 0x7d, 0x6d, 0x27, 0xdd, 0xdd, 0x59, 0x67, 0x66, 0xeb, 0x2e, 0x07, 0xcf, 0x1e, 0x3d,
+	0x7a, 0xf4, 0xec, 0x5f, 0xc2, 0x91, 0xa3, 0x27, 0x35, 0xf0, 0x67, 0x78, 0x31, 0xf3, 0xce, 0xa2,
+	0x25, 0x5c, 0x39, 0x75, 0x93, 0xe7, 0x79, 0xde, 0xdf, 0x93, 0x99, 0xb7, 0x43, 0x7c, 0x91, 0x1a,
+	0x50, 0xd1, 0x88, 0x8b, 0xf4, 0x5d, 0x0e, 0x4a, 0x80, 0x0e, 0x33, 0xae, 0x78, 0xa2, 0x83, 0x4c,
+	0x49, 0x23, 0xe9, 0xbd, 0x14, 0x72, 0xa3, 0x64, 0x1a, 0xfc, 0xf5, 0xf1, 0x01, 0xcf, 0x0c, 0xa8,
+	0xe0, 0x52, 0xb2, 0xb1, 0x3a, 0x94, 0x43, 0x89, 0xb9, 0xd0, 0x7e, 0xb9, 0x11, 0x0d, 0x3f, 0x92,
+	0x3a, 0x91, 0x3a, 0xec, 0x73, 0x0d, 0xe1, 0x64, 0xbb, 0x0f, 0x86, 0x6f, 0x87, 0x91, 0x14, 0xa9,
+	0xd3, 0xef, 0xfe, 0x9a, 0x27, 0x73, 0x1d, 0x64, 0xd2, 0x2d, 0xb2, 0x6a, 0x67, 0x95, 0x4c, 0xe7,
+	0xfd, 0x44, 0x18, 0x66, 0x44, 0x02, 0x32, 0x37, 0x5e, 0x7d, 0xa3, 0xde, 0x9c, 0xed, 0x52, 0xd4,
+	0x7a, 0x28, 0x1d, 0x3a, 0x85, 0x66, 0x64, 0xd9, 0x25, 0x06, 0x90, 0x49, 0x2d, 0x8c, 0xf7, 0xcf,
+	0xc6, 0x4c, 0x73, 0xf1, 0xfe, 0xad, 0xc0, 0x41, 0x03, 0x0b, 0x0d, 0x2a, 0x68, 0xd0, 0x96, 0x22,
+	0x6d, 0x6d, 0x1d, 0x7f, 0x5f, 0xaf, 0x7d, 0xfd, 0xb1, 0xde, 0x1c, 0x0a, 0x33, 0xca, 0xfb, 0x41,
+	0x24, 0x93, 0xb0, 0x6a, 0xe8, 0x7e, 0x36, 0xf5, 0x60, 0x1c, 0x9a, 0x32, 0x03, 0x8d, 0x01, 0xdd,
+	0x5d, 0x42, 0xc2, 0x73, 0x07, 0xa0, 0x01, 0x59, 0x71, 0x44, 0x28, 0x32, 0xa1, 0x4a, 0x96, 0x81,
+	0x12, 0x72, 0xe0, 0xcd, 0x60, 0xc5, 0xeb, 0x28, 0xed, 0xa2, 0xd2, 0x41, 0x81, 0x3e, 0x21, 0x8d,
+	0x7e, 0xae, 0x52, 0x67, 0x87, 0x01, 0xbb, 0x58, 0x77, 0x76, 0xa3, 0xde, 0x5c, 0xe8, 0xae, 0x59,
+	0xc7, 0xae, 0x33, 0x1c, 0x4c, 0xc3, 0x1e, 0x92, 0xb5, 0x0a, 0x13, 0x8d, 0x20, 0x1a, 0x6b, 0x4b,
+	0x63, 0xfd, 0x58, 0x46, 0x63, 0xef, 0x5f, 0x04, 0xae, 0x3a, 0xb9, 0x8d, 0x6a, 0x07, 0x54, 0xcb,
+	0x6a, 0x74, 0x87, 0xdc, 0x34, 0x45, 0x45, 0x52, 0x90, 0xc8, 0x09, 0x8f, 0x59, 0x2c, 0x12, 0x61,
+	0xbc, 0x39, 0x4c, 0xad, 0x98, 0x02, 0x31, 0x5d, 0xa7, 0xed, 0x8b, 0xc4, 0xb1, 0x12, 0x5e, 0x30,
+	0x05, 0x3a, 0x8f, 0x0d, 0x1b, 0x09, 0x6d, 0xa4, 0xbd, 0x09, 0x71, 0x04, 0xde, 0xbc, 0x63, 0x25,
+	0xbc, 0xe8, 0xa2, 0xfa, 0xca, 0x89, 0x3d, 0x71, 0x04, 0x74, 0x93, 0xac, 0xe8, 0x7c, 0x20, 0x59,
+	0xc4, 0xe3, 0x98, 0x0d, 0xb9, 0xae, 0x40, 0x0b, 0x18, 0xb9, 0x66, 0xa5, 0x36, 0x8f, 0xe3, 0x97,
+	0x5c, 0x3b, 0xca, 0x03, 0x47, 0x19, 0x4f, 0xaa, 0x7a, 0x63, 0x28, 0x35, 0x8b, 0x64, 0x9e, 0x1a,
+	0xef, 0x3f, 0xd7, 0x2d, 0xe1, 0xc5, 0xde, 0x04, 0xeb, 0xed, 0x41, 0xa9, 0xdb, 0x56, 0xa2, 0x4f,
+	0xc9, 0x1d, 0x9b, 0xe2, 0x91, 0x11, 0x13, 0x60, 0xd5, 0xbe, 0xe1, 0x61, 0xc8, 0xf7, 0x29, 0x28,
+	0x8f, 0x60, 0xd4, 0x4b, 0x78, 0xf1, 0x0c, 0x2d, 0x07, 0xce, 0xd1, 0x01, 0xf5, 0xda, 0xea, 0xb4,
+	0x4d, 0x7c, 0x9b, 0x37, 0x8a, 0xa7, 0xda, 0x0e, 0x91, 0xa9, 0x66, 0x6f, 0x45, 0x6c, 0x40, 0xb1,
+	0x18, 0xd2, 0xa1, 0x19, 0x79, 0x8b, 0x38, 0xe1, 0x76, 0xc2, 0x8b, 0xc3, 0x29, 0xd3, 0x0b, 0xf4,
+	0xec, 0xa3, 0x85, 0x7e, 0x20, 0x37, 0x2e, 0x5c, 0x1e, 0xf2, 0xc7, 0x50, 0x7a, 0x4b, 0x57, 0xbf,
+	0x73, 0x74, 0x7a, 0xe7, 0x3a, 0xa0, 0xf6, 0xa0, 0xa4, 0x1f, 0xeb, 0xa4, 0xf1, 0xe7, 0x5a, 0xcf,
+	0x3b, 0xe8, 0xdc, 0xfe, 0x07, 0xd5, 0x10, 0xbc, 0xe5, 0xab, 0x6f, 0xb1, 0x56, 0xed, 0x49, 0xd5,
+	0xa3, 0x77, 0xce, 0xa2, 0x2d, 0xe2, 0x47, 0xb1, 0x80, 0xd4, 0x30, 0x6d, 0xb8, 0xc9, 0xf5, 0xe5,
+	0xf5, 0xfc, 0x1f, 0xcf, 0xb3, 0xe1, 0x5c, 0x3d, 0x34, 0x5d, 0x5c, 0xd2, 0xc7, 0xb3, 0x9f, 0xbf,
+	0xac, 0xd7, 0x5a, 0xdd, 0xe3, 0x53, 0xbf, 0x7e, 0x72, 0xea, 0xd7, 0x7f, 0x9e, 0xfa, 0xf5, 0x4f,
+	0x67, 0x7e, 0xed, 0xe4, 0xcc, 0xaf, 0x7d, 0x3b, 0xf3, 0x6b, 0x6f, 0x1e, 0x4d, 0xd5, 0xac, 0x5e,
+	0xa1, 0x4d, 0xa9, 0x86, 0xe7, 0xdf, 0x61, 0x11, 0x5e, 0x7e, 0xbb, 0xb0, 0x7c, 0x7f, 0x0e, 0x1f,
+	0x96, 0x9d, 0xdf, 0x03, 0x00, 0xd1, 0x74, 0x1c, 0x9a, 0xdd, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -128,6 +265,89 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClientStatusChecksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClientStatusChecksPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TxQueryDepositSurcharge) > 0 {
+		for iNdEx := len(m.TxQueryDepositSurcharge) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxQueryDepositSurcharge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.QueryDepositPerKey) > 0 {
+		for iNdEx := len(m.QueryDepositPerKey) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryDepositPerKey[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxTransactionsFilterLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransactionsFilterLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxActiveQueriesPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveQueriesPerOwner))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxKvQueryKeysCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxKvQueryKeysCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SudoCallGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SudoCallGasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxResultHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxResultHistorySize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TxQueryRemovalLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TxQueryRemovalLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryChecksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiryChecksPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnExpiredQueryDeposit {
+		i--
+		if m.BurnExpiredQueryDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueryExpiryPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueryExpiryPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.QueryDeposit) > 0 {
 		for iNdEx := len(m.QueryDeposit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +396,48 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.QueryExpiryPeriod != 0 {
+		n += 1 + sovParams(uint64(m.QueryExpiryPeriod))
+	}
+	if m.BurnExpiredQueryDeposit {
+		n += 2
+	}
+	if m.ExpiryChecksPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ExpiryChecksPerBlock))
+	}
+	if m.TxQueryRemovalLimit != 0 {
+		n += 1 + sovParams(uint64(m.TxQueryRemovalLimit))
+	}
+	if m.MaxResultHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.MaxResultHistorySize))
+	}
+	if m.SudoCallGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.SudoCallGasLimit))
+	}
+	if m.MaxKvQueryKeysCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxKvQueryKeysCount))
+	}
+	if m.MaxActiveQueriesPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveQueriesPerOwner))
+	}
+	if m.MaxTransactionsFilterLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransactionsFilterLength))
+	}
+	if len(m.QueryDepositPerKey) > 0 {
+		for _, e := range m.QueryDepositPerKey {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TxQueryDepositSurcharge) > 0 {
+		for _, e := range m.TxQueryDepositSurcharge {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ClientStatusChecksPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ClientStatusChecksPerBlock))
+	}
 	return n
 }
 
@@ -267,6 +529,265 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryExpiryPeriod", wireType)
+			}
+			m.QueryExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryExpiryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnExpiredQueryDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnExpiredQueryDeposit = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryChecksPerBlock", wireType)
+			}
+			m.ExpiryChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryChecksPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxQueryRemovalLimit", wireType)
+			}
+			m.TxQueryRemovalLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxQueryRemovalLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultHistorySize", wireType)
+			}
+			m.MaxResultHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResultHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoCallGasLimit", wireType)
+			}
+			m.SudoCallGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SudoCallGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKvQueryKeysCount", wireType)
+			}
+			m.MaxKvQueryKeysCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKvQueryKeysCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveQueriesPerOwner", wireType)
+			}
+			m.MaxActiveQueriesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveQueriesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransactionsFilterLength", wireType)
+			}
+			m.MaxTransactionsFilterLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransactionsFilterLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryDepositPerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryDepositPerKey = append(m.QueryDepositPerKey, types.Coin{})
+			if err := m.QueryDepositPerKey[len(m.QueryDepositPerKey)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxQueryDepositSurcharge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxQueryDepositSurcharge = append(m.TxQueryDepositSurcharge, types.Coin{})
+			if err := m.TxQueryDepositSurcharge[len(m.TxQueryDepositSurcharge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatusChecksPerBlock", wireType)
+			}
+			m.ClientStatusChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientStatusChecksPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
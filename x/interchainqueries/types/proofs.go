@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+)
+
+// SoloMachineProofOpType is the type of the proof operation which carries the solo machine signature of a KV result.
+// A proof of a KV result submitted for a solo machine client must consist of exactly one operation of this type
+// with the marshaled solomachinetypes.TimestampedSignatureData as the data.
+const SoloMachineProofOpType = "solomachine:signature"
+
+// SoloMachineKVSignBytes returns the bytes a solo machine signs to prove the value of the key stored under the
+// storage prefix at the sequence. A nil value proves the absence of the key.
+func SoloMachineKVSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	storagePrefix string,
+	key []byte,
+	value []byte,
+) ([]byte, error) {
+	dataBz, err := cdc.Marshal(&StorageValue{
+		StoragePrefix: storagePrefix,
+		Key:           key,
+		Value:         value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &solomachinetypes.SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    solomachinetypes.UNSPECIFIED,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
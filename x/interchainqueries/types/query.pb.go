@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -177,6 +180,8 @@ type QueryRegisteredQueriesResponse struct {
 	RegisteredQueries []RegisteredQuery `protobuf:"bytes,1,rep,name=registered_queries,json=registeredQueries,proto3" json:"registered_queries"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// The Tendermint tx search queries the transactions filters of the TX queries of the page are rendered to.
+	TendermintQueries []TendermintQueries `protobuf:"bytes,3,rep,name=tendermint_queries,json=tendermintQueries,proto3" json:"tendermint_queries"`
 }
 
 func (m *QueryRegisteredQueriesResponse) Reset()         { *m = QueryRegisteredQueriesResponse{} }
@@ -226,6 +231,67 @@ func (m *QueryRegisteredQueriesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryRegisteredQueriesResponse) GetTendermintQueries() []TendermintQueries {
+	if m != nil {
+		return m.TendermintQueries
+	}
+	return nil
+}
+
+// TendermintQueries are the Tendermint tx search queries a relayer must run for a TX query. A transaction meets
+// the transactions filter of the query if it is found by any of the queries.
+type TendermintQueries struct {
+	QueryId uint64   `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Queries []string `protobuf:"bytes,2,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (m *TendermintQueries) Reset()         { *m = TendermintQueries{} }
+func (m *TendermintQueries) String() string { return proto.CompactTextString(m) }
+func (*TendermintQueries) ProtoMessage()    {}
+func (*TendermintQueries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{4}
+}
+func (m *TendermintQueries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TendermintQueries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TendermintQueries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TendermintQueries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TendermintQueries.Merge(m, src)
+}
+func (m *TendermintQueries) XXX_Size() int {
+	return m.Size()
+}
+func (m *TendermintQueries) XXX_DiscardUnknown() {
+	xxx_messageInfo_TendermintQueries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TendermintQueries proto.InternalMessageInfo
+
+func (m *TendermintQueries) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *TendermintQueries) GetQueries() []string {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
 type QueryRegisteredQueryRequest struct {
 	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
 }
@@ -234,7 +300,7 @@ func (m *QueryRegisteredQueryRequest) Reset()         { *m = QueryRegisteredQuer
 func (m *QueryRegisteredQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredQueryRequest) ProtoMessage()    {}
 func (*QueryRegisteredQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{4}
+	return fileDescriptor_eb803bedd4e52c75, []int{5}
 }
 func (m *QueryRegisteredQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,13 +338,16 @@ func (m *QueryRegisteredQueryRequest) GetQueryId() uint64 {
 
 type QueryRegisteredQueryResponse struct {
 	RegisteredQuery *RegisteredQuery `protobuf:"bytes,1,opt,name=registered_query,json=registeredQuery,proto3" json:"registered_query,omitempty"`
+	// The Tendermint tx search queries the transactions filter of a TX query is rendered to. A transaction meets
+	// the filter if it is found by any of the queries.
+	TendermintQueries []string `protobuf:"bytes,2,rep,name=tendermint_queries,json=tendermintQueries,proto3" json:"tendermint_queries,omitempty"`
 }
 
 func (m *QueryRegisteredQueryResponse) Reset()         { *m = QueryRegisteredQueryResponse{} }
 func (m *QueryRegisteredQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredQueryResponse) ProtoMessage()    {}
 func (*QueryRegisteredQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{5}
+	return fileDescriptor_eb803bedd4e52c75, []int{6}
 }
 func (m *QueryRegisteredQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,6 +383,13 @@ func (m *QueryRegisteredQueryResponse) GetRegisteredQuery() *RegisteredQuery {
 	return nil
 }
 
+func (m *QueryRegisteredQueryResponse) GetTendermintQueries() []string {
+	if m != nil {
+		return m.TendermintQueries
+	}
+	return nil
+}
+
 type QueryRegisteredQueryResultRequest struct {
 	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
 }
@@ -322,7 +398,7 @@ func (m *QueryRegisteredQueryResultRequest) Reset()         { *m = QueryRegister
 func (m *QueryRegisteredQueryResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredQueryResultRequest) ProtoMessage()    {}
 func (*QueryRegisteredQueryResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{6}
+	return fileDescriptor_eb803bedd4e52c75, []int{7}
 }
 func (m *QueryRegisteredQueryResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +442,7 @@ func (m *QueryRegisteredQueryResultResponse) Reset()         { *m = QueryRegiste
 func (m *QueryRegisteredQueryResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRegisteredQueryResultResponse) ProtoMessage()    {}
 func (*QueryRegisteredQueryResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{7}
+	return fileDescriptor_eb803bedd4e52c75, []int{8}
 }
 func (m *QueryRegisteredQueryResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +488,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{8}
+	return fileDescriptor_eb803bedd4e52c75, []int{9}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +546,7 @@ func (m *QueryLastRemoteHeight) Reset()         { *m = QueryLastRemoteHeight{} }
 func (m *QueryLastRemoteHeight) String() string { return proto.CompactTextString(m) }
 func (*QueryLastRemoteHeight) ProtoMessage()    {}
 func (*QueryLastRemoteHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{9}
+	return fileDescriptor_eb803bedd4e52c75, []int{10}
 }
 func (m *QueryLastRemoteHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,14 +583,15 @@ func (m *QueryLastRemoteHeight) GetConnectionId() string {
 }
 
 type QueryLastRemoteHeightResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The latest height of the IBC client of the connection, along with the remote chain revision.
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
 }
 
 func (m *QueryLastRemoteHeightResponse) Reset()         { *m = QueryLastRemoteHeightResponse{} }
 func (m *QueryLastRemoteHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastRemoteHeightResponse) ProtoMessage()    {}
 func (*QueryLastRemoteHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb803bedd4e52c75, []int{10}
+	return fileDescriptor_eb803bedd4e52c75, []int{11}
 }
 func (m *QueryLastRemoteHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,862 +620,3395 @@ func (m *QueryLastRemoteHeightResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryLastRemoteHeightResponse proto.InternalMessageInfo
 
-func (m *QueryLastRemoteHeightResponse) GetHeight() uint64 {
+func (m *QueryLastRemoteHeightResponse) GetHeight() types.Height {
 	if m != nil {
 		return m.Height
 	}
-	return 0
+	return types.Height{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryParamsResponse")
-	proto.RegisterType((*QueryRegisteredQueriesRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueriesRequest")
-	proto.RegisterType((*QueryRegisteredQueriesResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueriesResponse")
-	proto.RegisterType((*QueryRegisteredQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryRequest")
-	proto.RegisterType((*QueryRegisteredQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResponse")
-	proto.RegisterType((*QueryRegisteredQueryResultRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResultRequest")
-	proto.RegisterType((*QueryRegisteredQueryResultResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResultResponse")
-	proto.RegisterType((*Transaction)(nil), "neutron.interchainadapter.interchainqueries.Transaction")
-	proto.RegisterType((*QueryLastRemoteHeight)(nil), "neutron.interchainadapter.interchainqueries.QueryLastRemoteHeight")
-	proto.RegisterType((*QueryLastRemoteHeightResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryLastRemoteHeightResponse")
+type QueryQueriesDueForUpdateRequest struct {
+	ConnectionId string             `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("interchainqueries/query.proto", fileDescriptor_eb803bedd4e52c75) }
-
-var fileDescriptor_eb803bedd4e52c75 = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x5b,
-	0x14, 0xee, 0x2d, 0x7d, 0x7d, 0x8f, 0x53, 0xde, 0x03, 0xee, 0x43, 0x83, 0x23, 0x0c, 0x38, 0x26,
-	0x4a, 0x34, 0xce, 0x04, 0x88, 0x11, 0x05, 0x45, 0x9a, 0x28, 0x14, 0x8d, 0xc2, 0xc4, 0x95, 0x1b,
-	0x72, 0xdb, 0xde, 0x4c, 0x27, 0xa1, 0x73, 0xcb, 0xcc, 0x2d, 0x32, 0x5b, 0x37, 0x6e, 0x4d, 0x5c,
-	0xf8, 0x0f, 0xf8, 0xc7, 0xb0, 0x24, 0x71, 0xe3, 0xc2, 0x18, 0x03, 0xc6, 0x9d, 0x0b, 0x17, 0xee,
-	0xcd, 0xdc, 0x7b, 0xfb, 0x73, 0x8a, 0x3a, 0xb4, 0xab, 0xce, 0xcc, 0x3d, 0xe7, 0x3b, 0xdf, 0xf7,
-	0x9d, 0x7b, 0x4e, 0x0a, 0xd3, 0xae, 0xc7, 0xa9, 0x5f, 0xaa, 0x10, 0xd7, 0xdb, 0xab, 0x53, 0xdf,
-	0xa5, 0x81, 0x15, 0xfd, 0x86, 0x66, 0xcd, 0x67, 0x9c, 0xe1, 0xeb, 0x1e, 0xad, 0x73, 0x9f, 0x79,
-	0x66, 0x2b, 0x8c, 0x94, 0x49, 0x8d, 0x53, 0xdf, 0x8c, 0x25, 0x6a, 0x13, 0x0e, 0x73, 0x98, 0xc8,
-	0xb3, 0xa2, 0x27, 0x09, 0xa1, 0x4d, 0x39, 0x8c, 0x39, 0xbb, 0xd4, 0x22, 0x35, 0xd7, 0x22, 0x9e,
-	0xc7, 0x38, 0xe1, 0x2e, 0xf3, 0x02, 0x75, 0x7a, 0xad, 0xc4, 0x82, 0x2a, 0x0b, 0xac, 0x22, 0x09,
-	0xa8, 0xac, 0x6c, 0xed, 0xcf, 0x17, 0x29, 0x27, 0xf3, 0x56, 0x8d, 0x38, 0xae, 0x27, 0x82, 0x55,
-	0xac, 0x1e, 0xe7, 0x5a, 0x23, 0x3e, 0xa9, 0x36, 0xb0, 0x66, 0xe2, 0xe7, 0x0e, 0xf5, 0x68, 0xe0,
-	0x36, 0x02, 0xb4, 0x78, 0x00, 0x3f, 0x90, 0x67, 0xc6, 0x04, 0xe0, 0xed, 0xa8, 0xfc, 0x96, 0x40,
-	0xb4, 0xe9, 0x5e, 0x9d, 0x06, 0xdc, 0xa8, 0xc0, 0xff, 0x1d, 0x5f, 0x83, 0x1a, 0xf3, 0x02, 0x8a,
-	0xb7, 0x21, 0x2b, 0x2b, 0x4f, 0xa2, 0x59, 0x34, 0x97, 0x5b, 0x58, 0x34, 0x13, 0xf8, 0x64, 0x4a,
-	0xb0, 0x7c, 0xe6, 0xf0, 0xd3, 0x4c, 0xca, 0x56, 0x40, 0xc6, 0x3b, 0x04, 0xd3, 0xa2, 0x94, 0x4d,
-	0x1d, 0x37, 0xe0, 0xd4, 0xa7, 0xe5, 0x6d, 0x19, 0xaf, 0xb8, 0xe0, 0xf3, 0x90, 0x65, 0x2f, 0x3c,
-	0xea, 0x47, 0x45, 0x87, 0xe6, 0x86, 0x6d, 0xf5, 0x86, 0x2f, 0xc3, 0xbf, 0x25, 0xe6, 0x79, 0xb4,
-	0x14, 0x59, 0xb5, 0xe3, 0x96, 0x27, 0xd3, 0xb3, 0x68, 0x6e, 0xd8, 0x1e, 0x69, 0x7d, 0x2c, 0x94,
-	0xf1, 0x43, 0x80, 0x96, 0x9f, 0x93, 0x43, 0x82, 0xf5, 0x15, 0x53, 0x9a, 0x6f, 0x46, 0xe6, 0x9b,
-	0xb2, 0xed, 0xca, 0x7c, 0x73, 0x8b, 0x38, 0x54, 0x15, 0xb6, 0xdb, 0x32, 0x8d, 0x8f, 0x08, 0xf4,
-	0xd3, 0x68, 0x2a, 0x73, 0xf6, 0x00, 0xfb, 0xcd, 0xc3, 0x1d, 0x25, 0x5a, 0x70, 0xce, 0x2d, 0xac,
-	0x24, 0x32, 0xaa, 0xb3, 0x46, 0xa8, 0x1c, 0x1b, 0xf7, 0xbb, 0x4b, 0xe3, 0xf5, 0x0e, 0x75, 0x69,
-	0xa1, 0xee, 0xea, 0x6f, 0xd5, 0x49, 0xbe, 0x1d, 0xf2, 0x96, 0xe0, 0x62, 0x0f, 0x75, 0x61, 0xa3,
-	0x05, 0x17, 0xe0, 0x1f, 0x01, 0x14, 0xb9, 0x1c, 0x75, 0x3e, 0x63, 0xff, 0x2d, 0xde, 0x0b, 0x65,
-	0xe3, 0x15, 0x82, 0xa9, 0xde, 0xa9, 0xca, 0x16, 0x07, 0xc6, 0xba, 0x6c, 0x09, 0xd5, 0xed, 0xe9,
-	0xcb, 0x14, 0x7b, 0xb4, 0xd3, 0x8e, 0xd0, 0xb8, 0x07, 0x97, 0x4e, 0x21, 0x52, 0xdf, 0xe5, 0x7f,
-	0xa0, 0x64, 0x1f, 0x8c, 0x5f, 0xe5, 0x2b, 0x39, 0x5b, 0x90, 0xf5, 0xc5, 0x17, 0x25, 0x62, 0x29,
-	0x91, 0x88, 0x76, 0x44, 0x85, 0x63, 0x14, 0x20, 0xf7, 0xcc, 0x27, 0x5e, 0x40, 0xc4, 0x9d, 0xc5,
-	0xff, 0x41, 0xba, 0xc9, 0x2d, 0xed, 0x96, 0xa3, 0xeb, 0x5f, 0xa1, 0xae, 0x53, 0xe1, 0xa2, 0xbf,
-	0x19, 0x5b, 0xbd, 0x61, 0x0c, 0x99, 0x32, 0xe1, 0x44, 0xdc, 0xe9, 0x11, 0x5b, 0x3c, 0x1b, 0x2b,
-	0x70, 0x4e, 0x54, 0x78, 0x4c, 0x02, 0x6e, 0xd3, 0x2a, 0xe3, 0x74, 0x43, 0x06, 0xc7, 0x66, 0x05,
-	0xc5, 0x67, 0xc5, 0xb8, 0xa5, 0x26, 0xb1, 0x3b, 0xbb, 0xa9, 0xbd, 0x45, 0x05, 0xb5, 0x53, 0x59,
-	0x78, 0x3b, 0x0c, 0x7f, 0x89, 0x4c, 0x7c, 0x88, 0x20, 0x2b, 0xc7, 0x1c, 0xaf, 0x26, 0x37, 0xa6,
-	0x63, 0x07, 0x69, 0xf7, 0xcf, 0x0e, 0x20, 0xf9, 0x1a, 0xcb, 0x2f, 0xdf, 0x7f, 0x79, 0x93, 0xbe,
-	0x89, 0x17, 0x2d, 0x85, 0x64, 0xc5, 0x17, 0xe1, 0x69, 0xbb, 0x15, 0xff, 0x40, 0x30, 0x1e, 0x1b,
-	0x76, 0xbc, 0x79, 0x96, 0x76, 0xf7, 0x5e, 0x6c, 0xda, 0xa3, 0x81, 0x60, 0x29, 0xad, 0xeb, 0x42,
-	0xeb, 0x1a, 0x5e, 0x4d, 0xa4, 0x35, 0xbe, 0xb0, 0xf0, 0x37, 0x04, 0xa3, 0x5d, 0x23, 0x80, 0x37,
-	0xfa, 0x65, 0xda, 0xd8, 0x24, 0x5a, 0x61, 0x00, 0x48, 0x4a, 0xf1, 0x03, 0xa1, 0x78, 0x15, 0xdf,
-	0xed, 0x47, 0x71, 0x88, 0xbf, 0x23, 0xc8, 0xb5, 0x8d, 0x25, 0x7e, 0x32, 0x08, 0x86, 0xad, 0x8d,
-	0xa3, 0x3d, 0x1d, 0x18, 0x9e, 0xd2, 0xbd, 0x26, 0x74, 0x2f, 0xe3, 0xdb, 0x89, 0x74, 0xcb, 0xad,
-	0x27, 0x57, 0x0e, 0xfe, 0x8a, 0x60, 0x2c, 0xb6, 0x23, 0xf2, 0xc9, 0x89, 0x76, 0x63, 0x68, 0x9b,
-	0xfd, 0x63, 0x34, 0x75, 0xe6, 0x85, 0xce, 0x15, 0x7c, 0x27, 0x61, 0x7f, 0x23, 0xa8, 0x1d, 0xb9,
-	0x99, 0xf2, 0xf6, 0xe1, 0xb1, 0x8e, 0x8e, 0x8e, 0x75, 0xf4, 0xf9, 0x58, 0x47, 0xaf, 0x4f, 0xf4,
-	0xd4, 0xd1, 0x89, 0x9e, 0xfa, 0x70, 0xa2, 0xa7, 0x9e, 0x2f, 0x39, 0x2e, 0xaf, 0xd4, 0x8b, 0x66,
-	0x89, 0x55, 0x1b, 0xf8, 0x37, 0x98, 0xef, 0x34, 0x6b, 0x1d, 0xf4, 0xc0, 0xe6, 0x61, 0x8d, 0x06,
-	0xc5, 0xac, 0xf8, 0xe3, 0xb4, 0xf8, 0x33, 0x00, 0x00, 0xff, 0xff, 0x29, 0x3e, 0x29, 0xf1, 0x43,
-	0x0a, 0x00, 0x00,
+func (m *QueryQueriesDueForUpdateRequest) Reset()         { *m = QueryQueriesDueForUpdateRequest{} }
+func (m *QueryQueriesDueForUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueriesDueForUpdateRequest) ProtoMessage()    {}
+func (*QueryQueriesDueForUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	RegisteredQueries(ctx context.Context, in *QueryRegisteredQueriesRequest, opts ...grpc.CallOption) (*QueryRegisteredQueriesResponse, error)
-	RegisteredQuery(ctx context.Context, in *QueryRegisteredQueryRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResponse, error)
-	QueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResultResponse, error)
-	LastRemoteHeight(ctx context.Context, in *QueryLastRemoteHeight, opts ...grpc.CallOption) (*QueryLastRemoteHeightResponse, error)
+func (m *QueryQueriesDueForUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryQueriesDueForUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueriesDueForUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryQueriesDueForUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueriesDueForUpdateRequest.Merge(m, src)
+}
+func (m *QueryQueriesDueForUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueriesDueForUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueriesDueForUpdateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueriesDueForUpdateRequest proto.InternalMessageInfo
+
+func (m *QueryQueriesDueForUpdateRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RegisteredQueries(ctx context.Context, in *QueryRegisteredQueriesRequest, opts ...grpc.CallOption) (*QueryRegisteredQueriesResponse, error) {
-	out := new(QueryRegisteredQueriesResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/RegisteredQueries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQueriesDueForUpdateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RegisteredQuery(ctx context.Context, in *QueryRegisteredQueryRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResponse, error) {
-	out := new(QueryRegisteredQueryResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/RegisteredQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryQueriesDueForUpdateResponse struct {
+	RegisteredQueries []RegisteredQuery `protobuf:"bytes,1,rep,name=registered_queries,json=registeredQueries,proto3" json:"registered_queries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResultResponse, error) {
-	out := new(QueryRegisteredQueryResultResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/QueryResult", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryQueriesDueForUpdateResponse) Reset()         { *m = QueryQueriesDueForUpdateResponse{} }
+func (m *QueryQueriesDueForUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueriesDueForUpdateResponse) ProtoMessage()    {}
+func (*QueryQueriesDueForUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{13}
+}
+func (m *QueryQueriesDueForUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueriesDueForUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueriesDueForUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryQueriesDueForUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueriesDueForUpdateResponse.Merge(m, src)
+}
+func (m *QueryQueriesDueForUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueriesDueForUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueriesDueForUpdateResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastRemoteHeight(ctx context.Context, in *QueryLastRemoteHeight, opts ...grpc.CallOption) (*QueryLastRemoteHeightResponse, error) {
-	out := new(QueryLastRemoteHeightResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/LastRemoteHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueriesDueForUpdateResponse proto.InternalMessageInfo
+
+func (m *QueryQueriesDueForUpdateResponse) GetRegisteredQueries() []RegisteredQuery {
+	if m != nil {
+		return m.RegisteredQueries
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	RegisteredQueries(context.Context, *QueryRegisteredQueriesRequest) (*QueryRegisteredQueriesResponse, error)
-	RegisteredQuery(context.Context, *QueryRegisteredQueryRequest) (*QueryRegisteredQueryResponse, error)
-	QueryResult(context.Context, *QueryRegisteredQueryResultRequest) (*QueryRegisteredQueryResultResponse, error)
-	LastRemoteHeight(context.Context, *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error)
+func (m *QueryQueriesDueForUpdateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryResultHistoryRequest struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// is the lowest remote height of the returned results, inclusive
+	MinHeight uint64 `protobuf:"varint,2,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// is the highest remote height of the returned results, inclusive; zero value means no upper bound
+	MaxHeight uint64 `protobuf:"varint,3,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// is the revision of the remote chain the heights of the range belong to
+	Revision uint64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryResultHistoryRequest) Reset()         { *m = QueryResultHistoryRequest{} }
+func (m *QueryResultHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResultHistoryRequest) ProtoMessage()    {}
+func (*QueryResultHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{14}
 }
-func (*UnimplementedQueryServer) RegisteredQueries(ctx context.Context, req *QueryRegisteredQueriesRequest) (*QueryRegisteredQueriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredQueries not implemented")
+func (m *QueryResultHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RegisteredQuery(ctx context.Context, req *QueryRegisteredQueryRequest) (*QueryRegisteredQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisteredQuery not implemented")
+func (m *QueryResultHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResultHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryResult(ctx context.Context, req *QueryRegisteredQueryResultRequest) (*QueryRegisteredQueryResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryResult not implemented")
+func (m *QueryResultHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResultHistoryRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LastRemoteHeight(ctx context.Context, req *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastRemoteHeight not implemented")
+func (m *QueryResultHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryResultHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResultHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryResultHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryResultHistoryRequest) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_RegisteredQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredQueriesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredQueries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/RegisteredQueries",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredQueries(ctx, req.(*QueryRegisteredQueriesRequest))
+func (m *QueryResultHistoryRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_RegisteredQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredQueryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryResultHistoryRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegisteredQuery(ctx, in)
+	return 0
+}
+
+func (m *QueryResultHistoryRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/RegisteredQuery",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegisteredQuery(ctx, req.(*QueryRegisteredQueryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredQueryResultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryResult(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/QueryResult",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryResult(ctx, req.(*QueryRegisteredQueryResultRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryResultHistoryResponse struct {
+	// the results of the revision ordered by remote height
+	Results []QueryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func _Query_LastRemoteHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastRemoteHeight)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastRemoteHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/LastRemoteHeight",
+func (m *QueryResultHistoryResponse) Reset()         { *m = QueryResultHistoryResponse{} }
+func (m *QueryResultHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResultHistoryResponse) ProtoMessage()    {}
+func (*QueryResultHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{15}
+}
+func (m *QueryResultHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResultHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResultHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastRemoteHeight(ctx, req.(*QueryLastRemoteHeight))
+}
+func (m *QueryResultHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResultHistoryResponse.Merge(m, src)
+}
+func (m *QueryResultHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResultHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResultHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResultHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryResultHistoryResponse) GetResults() []QueryResult {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.interchainadapter.interchainqueries.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RegisteredQueries",
-			Handler:    _Query_RegisteredQueries_Handler,
-		},
-		{
-			MethodName: "RegisteredQuery",
-			Handler:    _Query_RegisteredQuery_Handler,
-		},
-		{
-			MethodName: "QueryResult",
-			Handler:    _Query_QueryResult_Handler,
-		},
-		{
-			MethodName: "LastRemoteHeight",
-			Handler:    _Query_LastRemoteHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchainqueries/query.proto",
+type QuerySudoFailuresRequest struct {
+	// is the id of the query the failures are returned for; zero value means failures of all the queries
+	QueryId    uint64             `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySudoFailuresRequest) Reset()         { *m = QuerySudoFailuresRequest{} }
+func (m *QuerySudoFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoFailuresRequest) ProtoMessage()    {}
+func (*QuerySudoFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{16}
+}
+func (m *QuerySudoFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySudoFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoFailuresRequest.Merge(m, src)
+}
+func (m *QuerySudoFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoFailuresRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QuerySudoFailuresRequest proto.InternalMessageInfo
+
+func (m *QuerySudoFailuresRequest) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySudoFailuresRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySudoFailuresResponse struct {
+	Failures []SudoFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySudoFailuresResponse) Reset()         { *m = QuerySudoFailuresResponse{} }
+func (m *QuerySudoFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoFailuresResponse) ProtoMessage()    {}
+func (*QuerySudoFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{17}
+}
+func (m *QuerySudoFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySudoFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoFailuresResponse.Merge(m, src)
+}
+func (m *QuerySudoFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoFailuresResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRegisteredQueriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySudoFailuresResponse proto.InternalMessageInfo
+
+func (m *QuerySudoFailuresResponse) GetFailures() []SudoFailure {
+	if m != nil {
+		return m.Failures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRegisteredQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySudoFailuresResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryRegisteredQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryDecodedQueryResultResponse struct {
+	KvResults []DecodedStorageValue `protobuf:"bytes,1,rep,name=kv_results,json=kvResults,proto3" json:"kv_results"`
+	Height    uint64                `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Revision  uint64                `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *QueryDecodedQueryResultResponse) Reset()         { *m = QueryDecodedQueryResultResponse{} }
+func (m *QueryDecodedQueryResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodedQueryResultResponse) ProtoMessage()    {}
+func (*QueryDecodedQueryResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{18}
+}
+func (m *QueryDecodedQueryResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodedQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodedQueryResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryDecodedQueryResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodedQueryResultResponse.Merge(m, src)
+}
+func (m *QueryDecodedQueryResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodedQueryResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodedQueryResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodedQueryResultResponse proto.InternalMessageInfo
+
+func (m *QueryDecodedQueryResultResponse) GetKvResults() []DecodedStorageValue {
+	if m != nil {
+		return m.KvResults
 	}
-	if len(m.Owners) > 0 {
-		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Owners[iNdEx])
-			copy(dAtA[i:], m.Owners[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Owners[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *QueryDecodedQueryResultResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryRegisteredQueriesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDecodedQueryResultResponse) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRegisteredQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DecodedStorageValue struct {
+	// is the substore name (bank, staking, etc.) the value is stored in
+	StoragePrefix string `protobuf:"bytes,1,opt,name=storage_prefix,json=storagePrefix,proto3" json:"storage_prefix,omitempty"`
+	Key           []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// is the full protobuf name of the decoded value type; empty if the value is unknown
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// is the value decoded into JSON; empty if the value is unknown
+	DecodedJson string `protobuf:"bytes,4,opt,name=decoded_json,json=decodedJson,proto3" json:"decoded_json,omitempty"`
+	// is the raw value; set only if the value is unknown
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *QueryRegisteredQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DecodedStorageValue) Reset()         { *m = DecodedStorageValue{} }
+func (m *DecodedStorageValue) String() string { return proto.CompactTextString(m) }
+func (*DecodedStorageValue) ProtoMessage()    {}
+func (*DecodedStorageValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{19}
+}
+func (m *DecodedStorageValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedStorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedStorageValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.RegisteredQueries) > 0 {
-		for iNdEx := len(m.RegisteredQueries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RegisteredQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *DecodedStorageValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedStorageValue.Merge(m, src)
+}
+func (m *DecodedStorageValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedStorageValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedStorageValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedStorageValue proto.InternalMessageInfo
+
+func (m *DecodedStorageValue) GetStoragePrefix() string {
+	if m != nil {
+		return m.StoragePrefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRegisteredQueryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DecodedStorageValue) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRegisteredQueryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DecodedStorageValue) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *QueryRegisteredQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
-		i--
-		dAtA[i] = 0x8
+func (m *DecodedStorageValue) GetDecodedJson() string {
+	if m != nil {
+		return m.DecodedJson
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRegisteredQueryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DecodedStorageValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRegisteredQueryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositEstimateRequest struct {
+	// is the type of the query: kv or tx
+	QueryType string `protobuf:"bytes,1,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	// is the amount of keys of a KV query
+	KeysCount uint64 `protobuf:"varint,2,opt,name=keys_count,json=keysCount,proto3" json:"keys_count,omitempty"`
 }
 
-func (m *QueryRegisteredQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RegisteredQuery != nil {
-		{
-			size, err := m.RegisteredQuery.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositEstimateRequest) Reset()         { *m = QueryDepositEstimateRequest{} }
+func (m *QueryDepositEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositEstimateRequest) ProtoMessage()    {}
+func (*QueryDepositEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{20}
+}
+func (m *QueryDepositEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRegisteredQueryResultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositEstimateRequest.Merge(m, src)
 }
-
-func (m *QueryRegisteredQueryResultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDepositEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositEstimateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRegisteredQueryResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryDepositEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryDepositEstimateRequest) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRegisteredQueryResultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDepositEstimateRequest) GetKeysCount() uint64 {
+	if m != nil {
+		return m.KeysCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRegisteredQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositEstimateResponse struct {
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (m *QueryRegisteredQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositEstimateResponse) Reset()         { *m = QueryDepositEstimateResponse{} }
+func (m *QueryDepositEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositEstimateResponse) ProtoMessage()    {}
+func (*QueryDepositEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb803bedd4e52c75, []int{21}
+}
+func (m *QueryDepositEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Transaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositEstimateResponse.Merge(m, src)
 }
-
-func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositEstimateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDepositEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositEstimateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLastRemoteHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryDepositEstimateResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLastRemoteHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryParamsResponse")
+	proto.RegisterType((*QueryRegisteredQueriesRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueriesRequest")
+	proto.RegisterType((*QueryRegisteredQueriesResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueriesResponse")
+	proto.RegisterType((*TendermintQueries)(nil), "neutron.interchainadapter.interchainqueries.TendermintQueries")
+	proto.RegisterType((*QueryRegisteredQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryRequest")
+	proto.RegisterType((*QueryRegisteredQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResponse")
+	proto.RegisterType((*QueryRegisteredQueryResultRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResultRequest")
+	proto.RegisterType((*QueryRegisteredQueryResultResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryRegisteredQueryResultResponse")
+	proto.RegisterType((*Transaction)(nil), "neutron.interchainadapter.interchainqueries.Transaction")
+	proto.RegisterType((*QueryLastRemoteHeight)(nil), "neutron.interchainadapter.interchainqueries.QueryLastRemoteHeight")
+	proto.RegisterType((*QueryLastRemoteHeightResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryLastRemoteHeightResponse")
+	proto.RegisterType((*QueryQueriesDueForUpdateRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryQueriesDueForUpdateRequest")
+	proto.RegisterType((*QueryQueriesDueForUpdateResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryQueriesDueForUpdateResponse")
+	proto.RegisterType((*QueryResultHistoryRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryResultHistoryRequest")
+	proto.RegisterType((*QueryResultHistoryResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryResultHistoryResponse")
+	proto.RegisterType((*QuerySudoFailuresRequest)(nil), "neutron.interchainadapter.interchainqueries.QuerySudoFailuresRequest")
+	proto.RegisterType((*QuerySudoFailuresResponse)(nil), "neutron.interchainadapter.interchainqueries.QuerySudoFailuresResponse")
+	proto.RegisterType((*QueryDecodedQueryResultResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryDecodedQueryResultResponse")
+	proto.RegisterType((*DecodedStorageValue)(nil), "neutron.interchainadapter.interchainqueries.DecodedStorageValue")
+	proto.RegisterType((*QueryDepositEstimateRequest)(nil), "neutron.interchainadapter.interchainqueries.QueryDepositEstimateRequest")
+	proto.RegisterType((*QueryDepositEstimateResponse)(nil), "neutron.interchainadapter.interchainqueries.QueryDepositEstimateResponse")
 }
 
-func (m *QueryLastRemoteHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("interchainqueries/query.proto", fileDescriptor_eb803bedd4e52c75) }
+
+var fileDescriptor_eb803bedd4e52c75 = []byte{
+	// 1409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x37, 0x69, 0x92, 0x7d, 0x49, 0xdb, 0x74, 0xda, 0xef, 0x57, 0xa9, 0x69, 0x36, 0xad,
+	0x11, 0x10, 0x81, 0x6a, 0x93, 0x54, 0x48, 0x81, 0x96, 0xfe, 0x48, 0x9a, 0x34, 0x49, 0x0b, 0xa4,
+	0x6e, 0x41, 0xa8, 0x1c, 0x2c, 0x67, 0x3d, 0x75, 0x86, 0x64, 0x3d, 0x5b, 0xcf, 0x78, 0xc9, 0x1e,
+	0xb8, 0x20, 0x71, 0x45, 0x95, 0x38, 0x72, 0xe5, 0xc4, 0x1f, 0xc0, 0x89, 0x03, 0xc7, 0x1e, 0x8b,
+	0xb8, 0x70, 0x2a, 0xa5, 0x41, 0xdc, 0x90, 0xe0, 0xc0, 0x11, 0x81, 0x3c, 0x33, 0x76, 0x76, 0xd7,
+	0x5e, 0x88, 0x77, 0xf7, 0xc2, 0x69, 0x3d, 0x33, 0x6f, 0x3e, 0xef, 0xf3, 0x99, 0xf7, 0xe6, 0xcd,
+	0x4b, 0x60, 0x86, 0x04, 0x1c, 0x87, 0xd5, 0x6d, 0x97, 0x04, 0x0f, 0x22, 0x1c, 0x12, 0xcc, 0xac,
+	0xf8, 0xb7, 0x69, 0xd6, 0x43, 0xca, 0x29, 0x7a, 0x25, 0xc0, 0x11, 0x0f, 0x69, 0x60, 0x1e, 0x98,
+	0xb9, 0x9e, 0x5b, 0xe7, 0x38, 0x34, 0x33, 0x1b, 0xf5, 0x53, 0x3e, 0xf5, 0xa9, 0xd8, 0x67, 0xc5,
+	0x5f, 0x12, 0x42, 0x3f, 0xe3, 0x53, 0xea, 0xef, 0x62, 0xcb, 0xad, 0x13, 0xcb, 0x0d, 0x02, 0xca,
+	0x5d, 0x4e, 0x68, 0xc0, 0xd4, 0xea, 0xcb, 0x55, 0xca, 0x6a, 0x94, 0x59, 0x5b, 0x2e, 0xc3, 0xd2,
+	0xb3, 0xd5, 0x98, 0xdf, 0xc2, 0xdc, 0x9d, 0xb7, 0xea, 0xae, 0x4f, 0x02, 0x61, 0xac, 0x6c, 0x2b,
+	0xad, 0xb6, 0x89, 0x55, 0x95, 0x92, 0x74, 0x3d, 0xab, 0xa5, 0xee, 0x86, 0x6e, 0x2d, 0xf1, 0x35,
+	0x9b, 0x5d, 0xf7, 0x71, 0x80, 0x19, 0x49, 0x0c, 0xf4, 0xac, 0x01, 0xdf, 0x4b, 0x37, 0x6f, 0x55,
+	0xad, 0x2a, 0x0d, 0xb1, 0x55, 0xdd, 0x25, 0x38, 0xe0, 0x56, 0x63, 0x5e, 0x7d, 0x49, 0x03, 0xe3,
+	0x14, 0xa0, 0xdb, 0x31, 0xff, 0x4d, 0xe1, 0xd2, 0xc6, 0x0f, 0x22, 0xcc, 0xb8, 0xb1, 0x0d, 0x27,
+	0xdb, 0x66, 0x59, 0x9d, 0x06, 0x0c, 0xa3, 0xdb, 0x30, 0x2a, 0xa9, 0x4d, 0x6b, 0x67, 0xb5, 0xb9,
+	0x89, 0x85, 0x0b, 0x66, 0x81, 0x83, 0x36, 0x25, 0xd8, 0xd2, 0xc8, 0xa3, 0x27, 0xb3, 0x43, 0xb6,
+	0x02, 0x32, 0xbe, 0xd4, 0x60, 0x46, 0xb8, 0xb2, 0xb1, 0x4f, 0x18, 0xc7, 0x21, 0xf6, 0x6e, 0x4b,
+	0x7b, 0xc5, 0x05, 0xfd, 0x1f, 0x46, 0xe9, 0x47, 0x01, 0x0e, 0x63, 0xa7, 0xc3, 0x73, 0x65, 0x5b,
+	0x8d, 0xd0, 0xf3, 0x70, 0xb4, 0x4a, 0x83, 0x00, 0x57, 0xe3, 0xb3, 0x76, 0x88, 0x37, 0x5d, 0x3a,
+	0xab, 0xcd, 0x95, 0xed, 0xc9, 0x83, 0xc9, 0x75, 0x0f, 0xad, 0x02, 0x1c, 0x04, 0x64, 0x7a, 0x58,
+	0xb0, 0x7e, 0xd1, 0x94, 0x11, 0x31, 0xe3, 0x88, 0x98, 0x32, 0x6f, 0x54, 0x5c, 0xcc, 0x4d, 0xd7,
+	0xc7, 0xca, 0xb1, 0xdd, 0xb2, 0xd3, 0xf8, 0xae, 0x04, 0x95, 0x6e, 0x34, 0xd5, 0xe1, 0x3c, 0x00,
+	0x14, 0xa6, 0x8b, 0x8e, 0x12, 0x2d, 0x38, 0x4f, 0x2c, 0x5c, 0x2a, 0x74, 0x50, 0xed, 0x3e, 0x9a,
+	0xea, 0xc4, 0x4e, 0x84, 0x9d, 0xae, 0xd1, 0x8d, 0x36, 0x75, 0x25, 0xa1, 0xee, 0xa5, 0x7f, 0x55,
+	0x27, 0xf9, 0xb6, 0xca, 0x43, 0x0c, 0x10, 0xc7, 0x81, 0x87, 0xc3, 0x1a, 0x09, 0x78, 0xca, 0x7d,
+	0x58, 0x70, 0xbf, 0x5c, 0x88, 0xfb, 0xdd, 0x14, 0x46, 0x91, 0x4c, 0xd8, 0xf3, 0xce, 0x05, 0x63,
+	0x0d, 0x4e, 0x64, 0xac, 0xd1, 0x69, 0x18, 0x17, 0x9c, 0xe3, 0x80, 0xc6, 0x49, 0x36, 0x62, 0x8f,
+	0x89, 0xf1, 0xba, 0x87, 0xa6, 0x61, 0x2c, 0x61, 0x56, 0x12, 0x99, 0x90, 0x0c, 0x8d, 0x45, 0x78,
+	0x2e, 0x27, 0x38, 0xcd, 0x24, 0x83, 0xba, 0x63, 0x1a, 0x5f, 0x6b, 0x70, 0x26, 0x7f, 0xab, 0x8a,
+	0xaa, 0x0f, 0x53, 0x1d, 0x51, 0x6d, 0xaa, 0xe4, 0xef, 0x2b, 0xa6, 0xf6, 0xf1, 0xf6, 0x68, 0x36,
+	0xd1, 0xf9, 0xdc, 0x10, 0x48, 0xa1, 0x39, 0x87, 0x77, 0x19, 0xce, 0x75, 0xe1, 0x1d, 0xed, 0xf2,
+	0x43, 0x08, 0x6f, 0x80, 0xf1, 0x4f, 0xfb, 0x95, 0xfa, 0x4d, 0x18, 0x0d, 0xc5, 0x8c, 0xd2, 0xbc,
+	0x58, 0x48, 0x73, 0x2b, 0xa2, 0xc2, 0x31, 0xd6, 0x61, 0xe2, 0x6e, 0xe8, 0x06, 0xcc, 0x15, 0x37,
+	0x14, 0x1d, 0x83, 0x52, 0xca, 0xad, 0x44, 0xbc, 0xf8, 0xb2, 0x6f, 0x63, 0xe2, 0x6f, 0x73, 0x91,
+	0xcd, 0x23, 0xb6, 0x1a, 0x21, 0x04, 0x23, 0x9e, 0xcb, 0x5d, 0x71, 0x83, 0x27, 0x6d, 0xf1, 0x6d,
+	0x5c, 0x82, 0xff, 0x09, 0x0f, 0xb7, 0x5c, 0xc6, 0x6d, 0x5c, 0xa3, 0x1c, 0xaf, 0x49, 0xe3, 0x4c,
+	0x65, 0xd0, 0xb2, 0x95, 0xc1, 0x70, 0x60, 0x26, 0x77, 0x77, 0xaa, 0x7d, 0xb1, 0x8d, 0xca, 0xc4,
+	0x82, 0x6e, 0x92, 0xad, 0xaa, 0x19, 0xd7, 0x52, 0x53, 0x55, 0xd0, 0xc6, 0xbc, 0x29, 0xf7, 0x24,
+	0x35, 0x4d, 0xda, 0x6f, 0x8c, 0x8c, 0x6b, 0x53, 0x25, 0xe3, 0x33, 0x0d, 0x66, 0x85, 0x07, 0x15,
+	0xb2, 0xeb, 0x11, 0x5e, 0xa5, 0xe1, 0xbb, 0x75, 0xcf, 0xe5, 0x49, 0x89, 0x39, 0x14, 0xd3, 0x8e,
+	0x1a, 0x56, 0xea, 0xb9, 0x86, 0x3d, 0xd1, 0xe0, 0x6c, 0x77, 0x42, 0xff, 0xfd, 0x2a, 0x66, 0x3c,
+	0xd4, 0xe0, 0x74, 0x4b, 0xce, 0xad, 0x11, 0xc6, 0xe9, 0x61, 0xaa, 0x00, 0x9a, 0x01, 0xa8, 0x91,
+	0xc0, 0x69, 0xcb, 0xbc, 0x72, 0x8d, 0x04, 0x2a, 0x9f, 0xe2, 0x65, 0x77, 0x2f, 0x59, 0x1e, 0x56,
+	0xcb, 0xee, 0x9e, 0x5a, 0xd6, 0x61, 0x3c, 0xc4, 0x0d, 0xc2, 0x62, 0xf6, 0x23, 0x62, 0x31, 0x1d,
+	0x1b, 0x0d, 0xd0, 0xf3, 0x18, 0xa9, 0xc3, 0x7e, 0x1f, 0xc6, 0xe4, 0xb5, 0x48, 0x4e, 0xb8, 0xe7,
+	0xfb, 0xa5, 0x4e, 0x37, 0x81, 0x33, 0x3e, 0x86, 0x69, 0xb1, 0x7a, 0x27, 0xf2, 0xe8, 0xaa, 0x4b,
+	0x76, 0xa3, 0x10, 0xb3, 0x43, 0x1c, 0xc4, 0xa0, 0x52, 0xed, 0xdb, 0x24, 0x12, 0xed, 0xfe, 0x95,
+	0xec, 0x7b, 0x30, 0x7e, 0x5f, 0xcd, 0xf5, 0xa4, 0xbb, 0x05, 0x54, 0xe9, 0x4e, 0xf1, 0x06, 0x97,
+	0x4c, 0xdf, 0x24, 0xd7, 0xf7, 0x3a, 0xae, 0x52, 0x2f, 0xbf, 0x3c, 0x62, 0x80, 0x9d, 0x86, 0xd3,
+	0x1e, 0xc2, 0xab, 0x85, 0xa4, 0x28, 0xf0, 0x3b, 0x9c, 0x86, 0xae, 0x8f, 0xdf, 0x73, 0x77, 0xa3,
+	0x44, 0x52, 0x79, 0xa7, 0x21, 0xbd, 0xb1, 0xae, 0x45, 0xb1, 0x35, 0xf1, 0x86, 0x3b, 0x12, 0xef,
+	0x0b, 0x0d, 0x4e, 0xe6, 0x80, 0xa3, 0x17, 0xe0, 0x18, 0x93, 0x63, 0xa7, 0x1e, 0xe2, 0xfb, 0x64,
+	0x4f, 0x95, 0x9c, 0xa3, 0x6a, 0x76, 0x53, 0x4c, 0xa2, 0x29, 0x18, 0xde, 0xc1, 0x4d, 0xe1, 0x6f,
+	0xd2, 0x8e, 0x3f, 0xe3, 0x0a, 0xcc, 0x9b, 0x75, 0x2c, 0x1c, 0x95, 0x6d, 0xf1, 0x8d, 0xce, 0xc1,
+	0xa4, 0x27, 0x7d, 0x38, 0x1f, 0x32, 0x95, 0xfd, 0x65, 0x7b, 0x42, 0xcd, 0x6d, 0x30, 0x1a, 0xa0,
+	0x53, 0x70, 0xa4, 0x11, 0x3b, 0x9e, 0x3e, 0x22, 0xa0, 0xe4, 0xc0, 0xf8, 0x40, 0x3d, 0xd8, 0xd7,
+	0x71, 0x9d, 0x32, 0xc2, 0x57, 0x18, 0x27, 0xb5, 0x96, 0xb2, 0x38, 0x03, 0x20, 0x33, 0x54, 0x78,
+	0x94, 0x04, 0xcb, 0x62, 0xe6, 0x6e, 0xec, 0x76, 0x06, 0x60, 0x07, 0x37, 0x99, 0x53, 0xa5, 0x51,
+	0x90, 0x5e, 0xd7, 0x78, 0x66, 0x39, 0x9e, 0x30, 0x3e, 0x4d, 0xde, 0xf4, 0x0c, 0x7a, 0x1a, 0xb6,
+	0x31, 0x4f, 0x2e, 0xa9, 0x98, 0x9d, 0x6e, 0x4b, 0x90, 0x24, 0x35, 0x96, 0x29, 0x09, 0x96, 0x5e,
+	0x8d, 0x83, 0xf1, 0xd5, 0x8f, 0xb3, 0x73, 0x3e, 0xe1, 0xdb, 0xd1, 0x96, 0x59, 0xa5, 0x35, 0x4b,
+	0x1a, 0xab, 0x9f, 0xf3, 0xcc, 0xdb, 0xb1, 0x62, 0x9e, 0x4c, 0x6c, 0x60, 0x76, 0x82, 0xbd, 0xf0,
+	0x14, 0xc1, 0x11, 0xf9, 0xb6, 0x3f, 0xd2, 0x60, 0x54, 0x76, 0xbf, 0xe8, 0x4a, 0xf1, 0x1b, 0xde,
+	0xd6, 0x9a, 0xeb, 0x57, 0x7b, 0x07, 0x90, 0xf2, 0x8d, 0x8b, 0x9f, 0x7c, 0xff, 0xf3, 0xe7, 0xa5,
+	0xd7, 0xd0, 0x05, 0x4b, 0x21, 0x59, 0x99, 0x7d, 0x56, 0xb7, 0xbf, 0x49, 0xd0, 0x1f, 0x1a, 0x9c,
+	0xc8, 0xf4, 0xc0, 0x68, 0xa3, 0x97, 0xba, 0x95, 0xdf, 0xef, 0xeb, 0x37, 0x07, 0x82, 0xa5, 0xb4,
+	0xde, 0x10, 0x5a, 0xaf, 0xa1, 0x2b, 0x85, 0xb4, 0x66, 0x5f, 0x40, 0xf4, 0xab, 0x06, 0xc7, 0x3b,
+	0x5e, 0x34, 0xb4, 0xd6, 0x2f, 0xd3, 0xe4, 0x6d, 0xd2, 0xd7, 0x07, 0x80, 0xa4, 0x14, 0xaf, 0x08,
+	0xc5, 0x57, 0xd0, 0x9b, 0xfd, 0x28, 0x6e, 0xa2, 0xdf, 0x35, 0x98, 0x68, 0x29, 0x79, 0xe8, 0xed,
+	0x41, 0x30, 0x3c, 0x68, 0x4d, 0xf5, 0x77, 0x06, 0x86, 0xa7, 0x74, 0x5f, 0x13, 0xba, 0x2f, 0xa2,
+	0xd7, 0x0b, 0xe9, 0x96, 0x65, 0x46, 0x56, 0x70, 0xf4, 0x8b, 0x06, 0x53, 0x99, 0x66, 0x72, 0xa9,
+	0x38, 0xd1, 0x4e, 0x0c, 0x7d, 0xa3, 0x7f, 0x8c, 0x54, 0xe7, 0x92, 0xd0, 0x79, 0x09, 0xbd, 0x51,
+	0x30, 0xbe, 0x31, 0x94, 0x6a, 0x61, 0xd0, 0x5f, 0x1a, 0x9c, 0xcc, 0x69, 0x02, 0xd1, 0xad, 0xe2,
+	0x3c, 0xbb, 0x37, 0xb7, 0xfa, 0x5b, 0x03, 0x42, 0x53, 0xc2, 0x6f, 0x0a, 0xe1, 0x2b, 0x68, 0xb9,
+	0x70, 0x80, 0x09, 0x66, 0x8e, 0x17, 0x61, 0xe7, 0x3e, 0x0d, 0x9d, 0x48, 0x2a, 0xfd, 0x4d, 0x03,
+	0xd4, 0x92, 0x45, 0xaa, 0x31, 0x43, 0xab, 0xbd, 0xf6, 0x5f, 0xed, 0xbd, 0xa6, 0x7e, 0xa3, 0x6f,
+	0x1c, 0x25, 0x7a, 0x5d, 0x88, 0x5e, 0x46, 0xd7, 0x7a, 0xce, 0x6a, 0x67, 0x5b, 0x69, 0xfb, 0x49,
+	0x83, 0xc9, 0xd6, 0x76, 0x0c, 0xad, 0x14, 0x27, 0x99, 0xd3, 0x4e, 0xea, 0xab, 0xfd, 0xc2, 0xf4,
+	0x95, 0xd8, 0x2c, 0xf2, 0xa8, 0x93, 0x76, 0x7f, 0x7f, 0x6a, 0x80, 0xb2, 0xfd, 0xda, 0xc0, 0x8b,
+	0x57, 0x0f, 0xf7, 0xa4, 0x7b, 0x17, 0xd9, 0x63, 0x8c, 0x93, 0xc6, 0xab, 0xad, 0x82, 0xc5, 0xaf,
+	0x54, 0x47, 0xd7, 0xd3, 0xcb, 0x2b, 0x95, 0xdf, 0x96, 0xe9, 0xeb, 0x03, 0x40, 0xea, 0xeb, 0x95,
+	0x52, 0x9d, 0x95, 0x83, 0x15, 0xdc, 0x92, 0xfd, 0xe8, 0x59, 0x45, 0x7b, 0xfc, 0xac, 0xa2, 0x3d,
+	0x7d, 0x56, 0xd1, 0x1e, 0xee, 0x57, 0x86, 0x1e, 0xef, 0x57, 0x86, 0x7e, 0xd8, 0xaf, 0x0c, 0xdd,
+	0x5b, 0x6c, 0xe9, 0xd7, 0x94, 0x8b, 0xf3, 0x34, 0xf4, 0x53, 0x77, 0x7b, 0x39, 0xf0, 0xa2, 0x8b,
+	0xdb, 0x1a, 0x15, 0xff, 0x18, 0xbd, 0xf0, 0xf7, 0x00, 0xec, 0x83, 0xcb, 0x6d, 0x64, 0x16, 0x00,
+	0x00,
 }
 
-func (m *QueryLastRemoteHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	RegisteredQueries(ctx context.Context, in *QueryRegisteredQueriesRequest, opts ...grpc.CallOption) (*QueryRegisteredQueriesResponse, error)
+	RegisteredQuery(ctx context.Context, in *QueryRegisteredQueryRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResponse, error)
+	QueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResultResponse, error)
+	LastRemoteHeight(ctx context.Context, in *QueryLastRemoteHeight, opts ...grpc.CallOption) (*QueryLastRemoteHeightResponse, error)
+	// QueriesDueForUpdate returns the registered queries which are due for an update at the current height.
+	QueriesDueForUpdate(ctx context.Context, in *QueryQueriesDueForUpdateRequest, opts ...grpc.CallOption) (*QueryQueriesDueForUpdateResponse, error)
+	// QueryResultHistory returns the results kept in the result history of a KV query
+	// for the remote heights in the given range.
+	QueryResultHistory(ctx context.Context, in *QueryResultHistoryRequest, opts ...grpc.CallOption) (*QueryResultHistoryResponse, error)
+	// SudoFailures returns the failed calls of the query owner contracts sudo handlers with the submitted
+	// query results.
+	SudoFailures(ctx context.Context, in *QuerySudoFailuresRequest, opts ...grpc.CallOption) (*QuerySudoFailuresResponse, error)
+	// DecodedQueryResult returns the last submitted result of a KV query with the values of the
+	// well-known store keys decoded into JSON.
+	DecodedQueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryDecodedQueryResultResponse, error)
+	// DepositEstimate returns the deposit collected on registration of a query of the given type and size.
+	DepositEstimate(ctx context.Context, in *QueryDepositEstimateRequest, opts ...grpc.CallOption) (*QueryDepositEstimateResponse, error)
 }
 
-func (m *QueryLastRemoteHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryLastRemoteHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) RegisteredQueries(ctx context.Context, in *QueryRegisteredQueriesRequest, opts ...grpc.CallOption) (*QueryRegisteredQueriesResponse, error) {
+	out := new(QueryRegisteredQueriesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/RegisteredQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RegisteredQuery(ctx context.Context, in *QueryRegisteredQueryRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResponse, error) {
+	out := new(QueryRegisteredQueryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/RegisteredQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) QueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryRegisteredQueryResultResponse, error) {
+	out := new(QueryRegisteredQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/QueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RegisteredQueries) > 0 {
-		for _, e := range m.RegisteredQueries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) LastRemoteHeight(ctx context.Context, in *QueryLastRemoteHeight, opts ...grpc.CallOption) (*QueryLastRemoteHeightResponse, error) {
+	out := new(QueryLastRemoteHeightResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/LastRemoteHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryId != 0 {
-		n += 1 + sovQuery(uint64(m.QueryId))
+func (c *queryClient) QueriesDueForUpdate(ctx context.Context, in *QueryQueriesDueForUpdateRequest, opts ...grpc.CallOption) (*QueryQueriesDueForUpdateResponse, error) {
+	out := new(QueryQueriesDueForUpdateResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/QueriesDueForUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RegisteredQuery != nil {
-		l = m.RegisteredQuery.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) QueryResultHistory(ctx context.Context, in *QueryResultHistoryRequest, opts ...grpc.CallOption) (*QueryResultHistoryResponse, error) {
+	out := new(QueryResultHistoryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/QueryResultHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueryResultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryId != 0 {
-		n += 1 + sovQuery(uint64(m.QueryId))
+func (c *queryClient) SudoFailures(ctx context.Context, in *QuerySudoFailuresRequest, opts ...grpc.CallOption) (*QuerySudoFailuresResponse, error) {
+	out := new(QuerySudoFailuresResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/SudoFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRegisteredQueryResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DecodedQueryResult(ctx context.Context, in *QueryRegisteredQueryResultRequest, opts ...grpc.CallOption) (*QueryDecodedQueryResultResponse, error) {
+	out := new(QueryDecodedQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/DecodedQueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DepositEstimate(ctx context.Context, in *QueryDepositEstimateRequest, opts ...grpc.CallOption) (*QueryDepositEstimateResponse, error) {
+	out := new(QueryDepositEstimateResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Query/DepositEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Transaction) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	RegisteredQueries(context.Context, *QueryRegisteredQueriesRequest) (*QueryRegisteredQueriesResponse, error)
+	RegisteredQuery(context.Context, *QueryRegisteredQueryRequest) (*QueryRegisteredQueryResponse, error)
+	QueryResult(context.Context, *QueryRegisteredQueryResultRequest) (*QueryRegisteredQueryResultResponse, error)
+	LastRemoteHeight(context.Context, *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error)
+	// QueriesDueForUpdate returns the registered queries which are due for an update at the current height.
+	QueriesDueForUpdate(context.Context, *QueryQueriesDueForUpdateRequest) (*QueryQueriesDueForUpdateResponse, error)
+	// QueryResultHistory returns the results kept in the result history of a KV query
+	// for the remote heights in the given range.
+	QueryResultHistory(context.Context, *QueryResultHistoryRequest) (*QueryResultHistoryResponse, error)
+	// SudoFailures returns the failed calls of the query owner contracts sudo handlers with the submitted
+	// query results.
+	SudoFailures(context.Context, *QuerySudoFailuresRequest) (*QuerySudoFailuresResponse, error)
+	// DecodedQueryResult returns the last submitted result of a KV query with the values of the
+	// well-known store keys decoded into JSON.
+	DecodedQueryResult(context.Context, *QueryRegisteredQueryResultRequest) (*QueryDecodedQueryResultResponse, error)
+	// DepositEstimate returns the deposit collected on registration of a query of the given type and size.
+	DepositEstimate(context.Context, *QueryDepositEstimateRequest) (*QueryDepositEstimateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredQueries(ctx context.Context, req *QueryRegisteredQueriesRequest) (*QueryRegisteredQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredQueries not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredQuery(ctx context.Context, req *QueryRegisteredQueryRequest) (*QueryRegisteredQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredQuery not implemented")
+}
+func (*UnimplementedQueryServer) QueryResult(ctx context.Context, req *QueryRegisteredQueryResultRequest) (*QueryRegisteredQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResult not implemented")
+}
+func (*UnimplementedQueryServer) LastRemoteHeight(ctx context.Context, req *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastRemoteHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueriesDueForUpdate(ctx context.Context, req *QueryQueriesDueForUpdateRequest) (*QueryQueriesDueForUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueriesDueForUpdate not implemented")
+}
+func (*UnimplementedQueryServer) QueryResultHistory(ctx context.Context, req *QueryResultHistoryRequest) (*QueryResultHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryResultHistory not implemented")
+}
+func (*UnimplementedQueryServer) SudoFailures(ctx context.Context, req *QuerySudoFailuresRequest) (*QuerySudoFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoFailures not implemented")
+}
+func (*UnimplementedQueryServer) DecodedQueryResult(ctx context.Context, req *QueryRegisteredQueryResultRequest) (*QueryDecodedQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodedQueryResult not implemented")
+}
+func (*UnimplementedQueryServer) DepositEstimate(ctx context.Context, req *QueryDepositEstimateRequest) (*QueryDepositEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositEstimate not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/Params",
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastRemoteHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RegisteredQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredQueries(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/RegisteredQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredQueries(ctx, req.(*QueryRegisteredQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastRemoteHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RegisteredQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/RegisteredQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredQuery(ctx, req.(*QueryRegisteredQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/QueryResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryResult(ctx, req.(*QueryRegisteredQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastRemoteHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastRemoteHeight)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastRemoteHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/LastRemoteHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastRemoteHeight(ctx, req.(*QueryLastRemoteHeight))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueriesDueForUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueriesDueForUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueriesDueForUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/QueriesDueForUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueriesDueForUpdate(ctx, req.(*QueryQueriesDueForUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryResultHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResultHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryResultHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/QueryResultHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryResultHistory(ctx, req.(*QueryResultHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SudoFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySudoFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SudoFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/SudoFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SudoFailures(ctx, req.(*QuerySudoFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecodedQueryResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredQueryResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodedQueryResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/DecodedQueryResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodedQueryResult(ctx, req.(*QueryRegisteredQueryResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Query/DepositEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositEstimate(ctx, req.(*QueryDepositEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.interchainadapter.interchainqueries.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RegisteredQueries",
+			Handler:    _Query_RegisteredQueries_Handler,
+		},
+		{
+			MethodName: "RegisteredQuery",
+			Handler:    _Query_RegisteredQuery_Handler,
+		},
+		{
+			MethodName: "QueryResult",
+			Handler:    _Query_QueryResult_Handler,
+		},
+		{
+			MethodName: "LastRemoteHeight",
+			Handler:    _Query_LastRemoteHeight_Handler,
+		},
+		{
+			MethodName: "QueriesDueForUpdate",
+			Handler:    _Query_QueriesDueForUpdate_Handler,
+		},
+		{
+			MethodName: "QueryResultHistory",
+			Handler:    _Query_QueryResultHistory_Handler,
+		},
+		{
+			MethodName: "SudoFailures",
+			Handler:    _Query_SudoFailures_Handler,
+		},
+		{
+			MethodName: "DecodedQueryResult",
+			Handler:    _Query_DecodedQueryResult_Handler,
+		},
+		{
+			MethodName: "DepositEstimate",
+			Handler:    _Query_DepositEstimate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchainqueries/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TendermintQueries) > 0 {
+		for iNdEx := len(m.TendermintQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TendermintQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisteredQueries) > 0 {
+		for iNdEx := len(m.RegisteredQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TendermintQueries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TendermintQueries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TendermintQueries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Queries[iNdEx])
+			copy(dAtA[i:], m.Queries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Queries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TendermintQueries) > 0 {
+		for iNdEx := len(m.TendermintQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TendermintQueries[iNdEx])
+			copy(dAtA[i:], m.TendermintQueries[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TendermintQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RegisteredQuery != nil {
+		{
+			size, err := m.RegisteredQuery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueryResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueryResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueryResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredQueryResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastRemoteHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastRemoteHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastRemoteHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastRemoteHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastRemoteHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastRemoteHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueriesDueForUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueriesDueForUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueriesDueForUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueriesDueForUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueriesDueForUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueriesDueForUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisteredQueries) > 0 {
+		for iNdEx := len(m.RegisteredQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResultHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResultHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResultHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResultHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResultHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResultHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodedQueryResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodedQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodedQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KvResults) > 0 {
+		for iNdEx := len(m.KvResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KvResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedStorageValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedStorageValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedStorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DecodedJson) > 0 {
+		i -= len(m.DecodedJson)
+		copy(dAtA[i:], m.DecodedJson)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecodedJson)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoragePrefix) > 0 {
+		i -= len(m.StoragePrefix)
+		copy(dAtA[i:], m.StoragePrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoragePrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeysCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeysCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredQueries) > 0 {
+		for _, e := range m.RegisteredQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TendermintQueries) > 0 {
+		for _, e := range m.TendermintQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TendermintQueries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	if len(m.Queries) > 0 {
+		for _, s := range m.Queries {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegisteredQuery != nil {
+		l = m.RegisteredQuery.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TendermintQueries) > 0 {
+		for _, s := range m.TendermintQueries {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueryResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueryResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastRemoteHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastRemoteHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueriesDueForUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueriesDueForUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredQueries) > 0 {
+		for _, e := range m.RegisteredQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResultHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryResultHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySudoFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySudoFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodedQueryResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KvResults) > 0 {
+		for _, e := range m.KvResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *DecodedStorageValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoragePrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DecodedJson)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeysCount != 0 {
+		n += 1 + sovQuery(uint64(m.KeysCount))
+	}
+	return n
+}
+
+func (m *QueryDepositEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredQueries = append(m.RegisteredQueries, RegisteredQuery{})
+			if err := m.RegisteredQueries[len(m.RegisteredQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TendermintQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TendermintQueries = append(m.TendermintQueries, TendermintQueries{})
+			if err := m.TendermintQueries[len(m.TendermintQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TendermintQueries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TendermintQueries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TendermintQueries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisteredQuery == nil {
+				m.RegisteredQuery = &RegisteredQuery{}
+			}
+			if err := m.RegisteredQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TendermintQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TendermintQueries = append(m.TendermintQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueryResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredQueryResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &QueryResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastRemoteHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,12 +4031,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastRemoteHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastRemoteHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1448,7 +4090,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastRemoteHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,15 +4113,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastRemoteHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastRemoteHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,7 +4148,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1531,7 +4173,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueriesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueriesDueForUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,45 +4196,13 @@ func (m *QueryRegisteredQueriesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueriesDueForUpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueriesDueForUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
@@ -1624,7 +4234,7 @@ func (m *QueryRegisteredQueriesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1681,7 +4291,7 @@ func (m *QueryRegisteredQueriesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueriesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueriesDueForUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,10 +4314,10 @@ func (m *QueryRegisteredQueriesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueriesDueForUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueriesDueForUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1801,7 +4411,7 @@ func (m *QueryRegisteredQueriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResultHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1824,10 +4434,10 @@ func (m *QueryRegisteredQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResultHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResultHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1849,6 +4459,63 @@ func (m *QueryRegisteredQueryRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1870,7 +4537,7 @@ func (m *QueryRegisteredQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResultHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,15 +4560,15 @@ func (m *QueryRegisteredQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResultHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResultHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredQuery", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1928,10 +4595,113 @@ func (m *QueryRegisteredQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegisteredQuery == nil {
-				m.RegisteredQuery = &RegisteredQuery{}
+			m.Results = append(m.Results, QueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySudoFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySudoFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySudoFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.RegisteredQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1956,7 +4726,7 @@ func (m *QueryRegisteredQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueryResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySudoFailuresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1979,17 +4749,17 @@ func (m *QueryRegisteredQueryResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySudoFailuresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySudoFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			m.QueryId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1999,11 +4769,62 @@ func (m *QueryRegisteredQueryResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueryId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, SudoFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2025,7 +4846,7 @@ func (m *QueryRegisteredQueryResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredQueryResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodedQueryResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2048,15 +4869,15 @@ func (m *QueryRegisteredQueryResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodedQueryResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodedQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KvResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2083,13 +4904,49 @@ func (m *QueryRegisteredQueryResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &QueryResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KvResults = append(m.KvResults, DecodedStorageValue{})
+			if err := m.KvResults[len(m.KvResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2111,7 +4968,7 @@ func (m *QueryRegisteredQueryResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transaction) Unmarshal(dAtA []byte) error {
+func (m *DecodedStorageValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2134,17 +4991,17 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedStorageValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedStorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoragePrefix", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2154,16 +5011,29 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoragePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2173,14 +5043,93 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2207,9 +5156,9 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2233,7 +5182,7 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastRemoteHeight) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2256,15 +5205,15 @@ func (m *QueryLastRemoteHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastRemoteHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastRemoteHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2292,8 +5241,27 @@ func (m *QueryLastRemoteHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.QueryType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysCount", wireType)
+			}
+			m.KeysCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2315,7 +5283,7 @@ func (m *QueryLastRemoteHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastRemoteHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2338,17 +5306,17 @@ func (m *QueryLastRemoteHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastRemoteHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastRemoteHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2358,11 +5326,26 @@ func (m *QueryLastRemoteHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
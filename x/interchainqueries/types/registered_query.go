@@ -13,3 +13,47 @@ func (queryInfo *RegisteredQuery) GetOwnerAddress() (creator sdk.AccAddress, err
 
 	return creator, nil
 }
+
+// TendermintQueries renders the transactions filter of a TX query into the Tendermint tx search queries a relayer
+// must run. There are no queries to run for a KV query.
+func (queryInfo *RegisteredQuery) TendermintQueries() ([]string, error) {
+	if !InterchainQueryType(queryInfo.QueryType).IsTX() {
+		return nil, nil
+	}
+
+	filter, err := ParseTransactionsFilter(queryInfo.TransactionsFilter)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidTransactionsFilter, "invalid transactions filter of query %d: %v", queryInfo.Id, err)
+	}
+
+	return filter.TendermintQueries(), nil
+}
+
+// IsExpired returns true if no results were submitted for the query for more than expiryPeriod blocks
+// since the last submitted result, since the query registration or since the query was resumed. A suspended
+// query never expires, results can't be submitted for it.
+func (queryInfo *RegisteredQuery) IsExpired(blockHeight uint64, expiryPeriod uint64) bool {
+	if queryInfo.Suspended {
+		return false
+	}
+
+	lastActivityHeight := queryInfo.RegisteredAtHeight
+	if queryInfo.LastSubmittedResultLocalHeight > lastActivityHeight {
+		lastActivityHeight = queryInfo.LastSubmittedResultLocalHeight
+	}
+	if queryInfo.ResumedAtHeight > lastActivityHeight {
+		lastActivityHeight = queryInfo.ResumedAtHeight
+	}
+
+	return blockHeight > lastActivityHeight+expiryPeriod
+}
+
+// IsDueForUpdate returns true if the query has never got a result or if at least UpdatePeriod blocks
+// passed since the last submitted result.
+func (queryInfo *RegisteredQuery) IsDueForUpdate(blockHeight uint64) bool {
+	if queryInfo.LastSubmittedResultLocalHeight == 0 {
+		return true
+	}
+
+	return blockHeight >= queryInfo.LastSubmittedResultLocalHeight+queryInfo.UpdatePeriod
+}
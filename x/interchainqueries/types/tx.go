@@ -11,6 +11,7 @@ import (
 
 var (
 	_ codectypes.UnpackInterfacesMessage = MsgSubmitQueryResult{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitQueryResults{}
 )
 
 func (msg MsgSubmitQueryResult) Route() string {
@@ -30,6 +31,14 @@ func (msg MsgSubmitQueryResult) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrEmptyResult, "query result can't be empty")
 	}
 
+	if msg.Result.Block != nil {
+		for _, tx := range msg.Result.Block.GetTxs() {
+			if tx == nil {
+				return sdkerrors.Wrap(ErrEmptyResult, "block transactions can't be empty")
+			}
+		}
+	}
+
 	if msg.QueryId == 0 {
 		return sdkerrors.Wrap(ErrInvalidQueryID, "query id cannot be equal zero")
 	}
@@ -91,11 +100,42 @@ func (msg MsgRegisterInterchainQuery) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidQueryType, "invalid query type")
 	}
 
+	if len(msg.Keys) > MaxKVQueryKeysCountLimit {
+		return sdkerrors.Wrapf(ErrTooManyKVQueryKeys, "keys count %d exceeds the limit %d", len(msg.Keys), MaxKVQueryKeysCountLimit)
+	}
+
+	if len(msg.TransactionsFilter) > MaxTransactionsFilterLengthLimit {
+		return sdkerrors.Wrapf(ErrInvalidTransactionsFilter, "transactions filter length %d exceeds the limit %d",
+			len(msg.TransactionsFilter), MaxTransactionsFilterLengthLimit)
+	}
+
+	if len(msg.TxMessagesFilter) > MaxTransactionsFilterLengthLimit {
+		return sdkerrors.Wrapf(ErrInvalidTxMessagesFilter, "transaction messages filter length %d exceeds the limit %d",
+			len(msg.TxMessagesFilter), MaxTransactionsFilterLengthLimit)
+	}
+
 	if InterchainQueryType(msg.QueryType).IsTX() {
 		if err := ValidateTransactionsFilter(msg.TransactionsFilter); err != nil {
 			return sdkerrors.Wrap(ErrInvalidTransactionsFilter, err.Error())
 		}
 	}
+
+	if msg.ResultHistorySize > 0 && !InterchainQueryType(msg.QueryType).IsKV() {
+		return sdkerrors.Wrap(ErrInvalidResultHistorySize, "result history can be kept only for KV queries")
+	}
+
+	if msg.TxMessagesFilter != "" {
+		if !InterchainQueryType(msg.QueryType).IsTX() {
+			return sdkerrors.Wrap(ErrInvalidTxMessagesFilter, "transaction messages filter can be set only for TX queries")
+		}
+		if _, err := ParseTxMessagesFilter(msg.TxMessagesFilter); err != nil {
+			return err
+		}
+	}
+
+	if !msg.SubmissionReward.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid submission reward: %s", msg.SubmissionReward)
+	}
 	return nil
 }
 
@@ -112,6 +152,62 @@ func (msg MsgRegisterInterchainQuery) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgSubmitQueryResults) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubmitQueryResults) Type() string {
+	return "submit-query-results"
+}
+
+func (msg MsgSubmitQueryResults) ValidateBasic() error {
+	if len(msg.Results) == 0 {
+		return sdkerrors.Wrap(ErrEmptyResult, "query results can't be empty")
+	}
+
+	seen := make(map[uint64]struct{}, len(msg.Results))
+	for _, result := range msg.Results {
+		if result.QueryId == 0 {
+			return sdkerrors.Wrap(ErrInvalidQueryID, "query id cannot be equal zero")
+		}
+
+		if _, ok := seen[result.QueryId]; ok {
+			return sdkerrors.Wrapf(ErrInvalidQueryID, "duplicated result for query id %d", result.QueryId)
+		}
+		seen[result.QueryId] = struct{}{}
+
+		if len(result.KvResults) == 0 {
+			return sdkerrors.Wrapf(ErrEmptyResult, "query result for query id %d can't be empty", result.QueryId)
+		}
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+
+	if strings.TrimSpace(msg.ClientId) == "" {
+		return sdkerrors.Wrap(ErrInvalidClientID, "client id cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitQueryResults) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSubmitQueryResults) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg MsgSubmitQueryResult) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var header exported.Header
@@ -123,7 +219,13 @@ func (msg MsgSubmitQueryResult) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 		return err
 	}
 
-	return nil
+	return unpacker.UnpackAny(msg.Result.GetHeader(), &header)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitQueryResults) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var header exported.Header
+	return unpacker.UnpackAny(msg.Header, &header)
 }
 
 func (msg MsgRemoveInterchainQueryRequest) ValidateBasic() error {
@@ -160,8 +262,28 @@ func (msg MsgUpdateInterchainQueryRequest) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidQueryID, "query_id cannot be empty or equal to 0")
 	}
 
-	if len(msg.GetNewKeys()) == 0 && msg.GetNewUpdatePeriod() == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "one of new_keys or new_update_period should be set")
+	if len(msg.GetNewKeys()) == 0 && msg.GetNewUpdatePeriod() == 0 &&
+		msg.GetNewTransactionsFilter() == "" && msg.GetNewConnectionId() == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"one of new_keys, new_update_period, new_transactions_filter or new_connection_id should be set")
+	}
+
+	if len(msg.GetNewKeys()) > MaxKVQueryKeysCountLimit {
+		return sdkerrors.Wrapf(ErrTooManyKVQueryKeys, "keys count %d exceeds the limit %d", len(msg.GetNewKeys()), MaxKVQueryKeysCountLimit)
+	}
+
+	if msg.GetNewTransactionsFilter() != "" {
+		if len(msg.GetNewTransactionsFilter()) > MaxTransactionsFilterLengthLimit {
+			return sdkerrors.Wrapf(ErrInvalidTransactionsFilter, "transactions filter length %d exceeds the limit %d",
+				len(msg.GetNewTransactionsFilter()), MaxTransactionsFilterLengthLimit)
+		}
+		if err := ValidateTransactionsFilter(msg.GetNewTransactionsFilter()); err != nil {
+			return sdkerrors.Wrap(ErrInvalidTransactionsFilter, err.Error())
+		}
+	}
+
+	if msg.GetNewConnectionId() != "" && strings.TrimSpace(msg.GetNewConnectionId()) == "" {
+		return sdkerrors.Wrap(ErrInvalidConnectionID, "connection id cannot be empty")
 	}
 
 	if strings.TrimSpace(msg.Sender) == "" {
@@ -186,3 +308,126 @@ func (msg MsgUpdateInterchainQueryRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgFundQueryReward) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFundQueryReward) Type() string {
+	return "fund-query-reward"
+}
+
+func (msg MsgFundQueryReward) ValidateBasic() error {
+	if msg.GetQueryId() == 0 {
+		return sdkerrors.Wrap(ErrInvalidQueryID, "query_id cannot be empty or equal to 0")
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid reward amount: %s", msg.Amount)
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+	return nil
+}
+
+func (msg MsgFundQueryReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFundQueryReward) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgRetrySudoFailure) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRetrySudoFailure) Type() string {
+	return "retry-sudo-failure"
+}
+
+func (msg MsgRetrySudoFailure) ValidateBasic() error {
+	if msg.GetQueryId() == 0 {
+		return sdkerrors.Wrap(ErrInvalidQueryID, "query_id cannot be empty or equal to 0")
+	}
+
+	if msg.GetFailureId() == 0 {
+		return sdkerrors.Wrap(ErrInvalidSudoFailure, "failure_id cannot be empty or equal to 0")
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+	return nil
+}
+
+func (msg MsgRetrySudoFailure) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRetrySudoFailure) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgTransferInterchainQueryOwnership) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTransferInterchainQueryOwnership) Type() string {
+	return "transfer-interchain-query-ownership"
+}
+
+func (msg MsgTransferInterchainQueryOwnership) ValidateBasic() error {
+	if msg.GetQueryId() == 0 {
+		return sdkerrors.Wrap(ErrInvalidQueryID, "query_id cannot be empty or equal to 0")
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse new owner address: %s", msg.NewOwner)
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+
+	if newOwner.Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new owner is the same as the sender")
+	}
+	return nil
+}
+
+func (msg MsgTransferInterchainQueryOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTransferInterchainQueryOwnership) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
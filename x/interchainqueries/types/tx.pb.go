@@ -6,11 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
-	types1 "github.com/tendermint/tendermint/abci/types"
-	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -35,7 +36,8 @@ type MsgRegisterInterchainQuery struct {
 	QueryType string `protobuf:"bytes,1,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
 	// is used to define KV-storage keys for which we want to get values from remote chain
 	Keys []*KVKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
-	// is used to define a filter for transaction search ICQ
+	// is used to define a filter for transaction search ICQ. String values of the filter can't contain
+	// quotes, Tendermint search doesn't support them.
 	TransactionsFilter string `protobuf:"bytes,3,opt,name=transactions_filter,json=transactionsFilter,proto3" json:"transactions_filter,omitempty"`
 	// is IBC connection ID for getting ConsensusState to verify proofs
 	ConnectionId string `protobuf:"bytes,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -43,6 +45,13 @@ type MsgRegisterInterchainQuery struct {
 	UpdatePeriod uint64 `protobuf:"varint,5,opt,name=update_period,json=updatePeriod,proto3" json:"update_period,omitempty"`
 	// is the signer of the message
 	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// is the amount of coins paid to a relayer for every successfully submitted query result
+	SubmissionReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=submission_reward,json=submissionReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"submission_reward"`
+	// is the amount of the last submitted results kept in the KV query result history
+	ResultHistorySize uint64 `protobuf:"varint,8,opt,name=result_history_size,json=resultHistorySize,proto3" json:"result_history_size,omitempty"`
+	// is the structured filter the messages of the submitted transactions are verified against on chain;
+	// empty value disables the verification
+	TxMessagesFilter string `protobuf:"bytes,9,opt,name=tx_messages_filter,json=txMessagesFilter,proto3" json:"tx_messages_filter,omitempty"`
 }
 
 func (m *MsgRegisterInterchainQuery) Reset()         { *m = MsgRegisterInterchainQuery{} }
@@ -120,6 +129,27 @@ func (m *MsgRegisterInterchainQuery) GetSender() string {
 	return ""
 }
 
+func (m *MsgRegisterInterchainQuery) GetSubmissionReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SubmissionReward
+	}
+	return nil
+}
+
+func (m *MsgRegisterInterchainQuery) GetResultHistorySize() uint64 {
+	if m != nil {
+		return m.ResultHistorySize
+	}
+	return 0
+}
+
+func (m *MsgRegisterInterchainQuery) GetTxMessagesFilter() string {
+	if m != nil {
+		return m.TxMessagesFilter
+	}
+	return ""
+}
+
 type MsgRegisterInterchainQueryResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -233,26 +263,21 @@ func (m *MsgSubmitQueryResult) GetResult() *QueryResult {
 	return nil
 }
 
-type QueryResult struct {
-	KvResults        []*StorageValue `protobuf:"bytes,1,rep,name=kv_results,json=kvResults,proto3" json:"kv_results,omitempty"`
-	Block            *Block          `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	Height           uint64          `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Revision         uint64          `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
-	AllowKvCallbacks bool            `protobuf:"varint,5,opt,name=allow_kv_callbacks,json=allowKvCallbacks,proto3" json:"allow_kv_callbacks,omitempty"`
+type MsgSubmitQueryResultResponse struct {
 }
 
-func (m *QueryResult) Reset()         { *m = QueryResult{} }
-func (m *QueryResult) String() string { return proto.CompactTextString(m) }
-func (*QueryResult) ProtoMessage()    {}
-func (*QueryResult) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitQueryResultResponse) Reset()         { *m = MsgSubmitQueryResultResponse{} }
+func (m *MsgSubmitQueryResultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResultResponse) ProtoMessage()    {}
+func (*MsgSubmitQueryResultResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3f1f36ccf3a8e51d, []int{3}
 }
-func (m *QueryResult) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitQueryResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitQueryResultResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -262,76 +287,45 @@ func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *QueryResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResult.Merge(m, src)
+func (m *MsgSubmitQueryResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResultResponse.Merge(m, src)
 }
-func (m *QueryResult) XXX_Size() int {
+func (m *MsgSubmitQueryResultResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResult.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryResult proto.InternalMessageInfo
-
-func (m *QueryResult) GetKvResults() []*StorageValue {
-	if m != nil {
-		return m.KvResults
-	}
-	return nil
-}
-
-func (m *QueryResult) GetBlock() *Block {
-	if m != nil {
-		return m.Block
-	}
-	return nil
-}
-
-func (m *QueryResult) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
-}
-
-func (m *QueryResult) GetRevision() uint64 {
-	if m != nil {
-		return m.Revision
-	}
-	return 0
-}
-
-func (m *QueryResult) GetAllowKvCallbacks() bool {
-	if m != nil {
-		return m.AllowKvCallbacks
-	}
-	return false
+func (m *MsgSubmitQueryResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResultResponse.DiscardUnknown(m)
 }
 
-type StorageValue struct {
-	// is the substore name (acc, staking, etc.)
-	StoragePrefix string `protobuf:"bytes,1,opt,name=storage_prefix,json=storagePrefix,proto3" json:"storage_prefix,omitempty"`
-	// is the key in IAVL store
-	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// is the value in IAVL store
-	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	// is the Merkle Proof which proves existence of key-value pair in IAVL storage
-	Proof *crypto.ProofOps `protobuf:"bytes,4,opt,name=Proof,proto3" json:"Proof,omitempty"`
-}
+var xxx_messageInfo_MsgSubmitQueryResultResponse proto.InternalMessageInfo
 
-func (m *StorageValue) Reset()         { *m = StorageValue{} }
-func (m *StorageValue) String() string { return proto.CompactTextString(m) }
-func (*StorageValue) ProtoMessage()    {}
-func (*StorageValue) Descriptor() ([]byte, []int) {
+// MsgSubmitQueryResults submits KV results of many queries obtained at the same height of the remote chain.
+type MsgSubmitQueryResults struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// is the IBC client ID for an IBC connection between Neutron chain and target chain (where the results were obtained from)
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// is the revision of the remote chain the results were obtained at
+	Revision uint64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// is the height of the remote chain the results were obtained at
+	Height  uint64          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Results []KVQueryResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+	// is the optional light client header of the remote chain for height + 1 the results are verified against;
+	// if it is set, the IBC client is updated with it before the results are verified
+	Header *types1.Any `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *MsgSubmitQueryResults) Reset()         { *m = MsgSubmitQueryResults{} }
+func (m *MsgSubmitQueryResults) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResults) ProtoMessage()    {}
+func (*MsgSubmitQueryResults) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3f1f36ccf3a8e51d, []int{4}
 }
-func (m *StorageValue) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitQueryResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitQueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StorageValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitQueryResults.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -341,67 +335,79 @@ func (m *StorageValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StorageValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StorageValue.Merge(m, src)
+func (m *MsgSubmitQueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResults.Merge(m, src)
 }
-func (m *StorageValue) XXX_Size() int {
+func (m *MsgSubmitQueryResults) XXX_Size() int {
 	return m.Size()
 }
-func (m *StorageValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_StorageValue.DiscardUnknown(m)
+func (m *MsgSubmitQueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResults.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StorageValue proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitQueryResults proto.InternalMessageInfo
 
-func (m *StorageValue) GetStoragePrefix() string {
+func (m *MsgSubmitQueryResults) GetSender() string {
 	if m != nil {
-		return m.StoragePrefix
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *StorageValue) GetKey() []byte {
+func (m *MsgSubmitQueryResults) GetClientId() string {
 	if m != nil {
-		return m.Key
+		return m.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (m *StorageValue) GetValue() []byte {
+func (m *MsgSubmitQueryResults) GetRevision() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *MsgSubmitQueryResults) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgSubmitQueryResults) GetResults() []KVQueryResult {
+	if m != nil {
+		return m.Results
 	}
 	return nil
 }
 
-func (m *StorageValue) GetProof() *crypto.ProofOps {
+func (m *MsgSubmitQueryResults) GetHeader() *types1.Any {
 	if m != nil {
-		return m.Proof
+		return m.Header
 	}
 	return nil
 }
 
-type Block struct {
-	// We need to know block X+1 to verify response of transaction for block X
-	// since LastResultsHash is root hash of all results from the txs from the previous block
-	NextBlockHeader *types.Any `protobuf:"bytes,1,opt,name=next_block_header,json=nextBlockHeader,proto3" json:"next_block_header,omitempty"`
-	// We need to know block X to verify inclusion of transaction for block X
-	Header *types.Any `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
-	Tx     *TxValue   `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+// KVQueryResult is a KV result of a single query submitted within MsgSubmitQueryResults.
+type KVQueryResult struct {
+	QueryId          uint64          `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	KvResults        []*StorageValue `protobuf:"bytes,2,rep,name=kv_results,json=kvResults,proto3" json:"kv_results,omitempty"`
+	AllowKvCallbacks bool            `protobuf:"varint,3,opt,name=allow_kv_callbacks,json=allowKvCallbacks,proto3" json:"allow_kv_callbacks,omitempty"`
 }
 
-func (m *Block) Reset()         { *m = Block{} }
-func (m *Block) String() string { return proto.CompactTextString(m) }
-func (*Block) ProtoMessage()    {}
-func (*Block) Descriptor() ([]byte, []int) {
+func (m *KVQueryResult) Reset()         { *m = KVQueryResult{} }
+func (m *KVQueryResult) String() string { return proto.CompactTextString(m) }
+func (*KVQueryResult) ProtoMessage()    {}
+func (*KVQueryResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3f1f36ccf3a8e51d, []int{5}
 }
-func (m *Block) XXX_Unmarshal(b []byte) error {
+func (m *KVQueryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KVQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KVQueryResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -411,61 +417,56 @@ func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Block) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Block.Merge(m, src)
+func (m *KVQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVQueryResult.Merge(m, src)
 }
-func (m *Block) XXX_Size() int {
+func (m *KVQueryResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *Block) XXX_DiscardUnknown() {
-	xxx_messageInfo_Block.DiscardUnknown(m)
+func (m *KVQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVQueryResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Block proto.InternalMessageInfo
+var xxx_messageInfo_KVQueryResult proto.InternalMessageInfo
 
-func (m *Block) GetNextBlockHeader() *types.Any {
+func (m *KVQueryResult) GetQueryId() uint64 {
 	if m != nil {
-		return m.NextBlockHeader
+		return m.QueryId
 	}
-	return nil
+	return 0
 }
 
-func (m *Block) GetHeader() *types.Any {
+func (m *KVQueryResult) GetKvResults() []*StorageValue {
 	if m != nil {
-		return m.Header
+		return m.KvResults
 	}
 	return nil
 }
 
-func (m *Block) GetTx() *TxValue {
+func (m *KVQueryResult) GetAllowKvCallbacks() bool {
 	if m != nil {
-		return m.Tx
+		return m.AllowKvCallbacks
 	}
-	return nil
+	return false
 }
 
-type TxValue struct {
-	Response *types1.ResponseDeliverTx `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	// is the Merkle Proof which proves existence of response in block with height next_block_header.Height
-	DeliveryProof *crypto.Proof `protobuf:"bytes,2,opt,name=delivery_proof,json=deliveryProof,proto3" json:"delivery_proof,omitempty"`
-	// is the Merkle Proof which proves existence of data in block with height header.Height
-	InclusionProof *crypto.Proof `protobuf:"bytes,3,opt,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`
-	// is body of the transaction
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+type MsgSubmitQueryResultsResponse struct {
+	// is the submission status of every result in the order of the message results
+	Results []QueryResultSubmission `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *TxValue) Reset()         { *m = TxValue{} }
-func (m *TxValue) String() string { return proto.CompactTextString(m) }
-func (*TxValue) ProtoMessage()    {}
-func (*TxValue) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitQueryResultsResponse) Reset()         { *m = MsgSubmitQueryResultsResponse{} }
+func (m *MsgSubmitQueryResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResultsResponse) ProtoMessage()    {}
+func (*MsgSubmitQueryResultsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3f1f36ccf3a8e51d, []int{6}
 }
-func (m *TxValue) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitQueryResultsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitQueryResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitQueryResultsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -475,61 +476,45 @@ func (m *TxValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TxValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxValue.Merge(m, src)
+func (m *MsgSubmitQueryResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResultsResponse.Merge(m, src)
 }
-func (m *TxValue) XXX_Size() int {
+func (m *MsgSubmitQueryResultsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxValue.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TxValue proto.InternalMessageInfo
-
-func (m *TxValue) GetResponse() *types1.ResponseDeliverTx {
-	if m != nil {
-		return m.Response
-	}
-	return nil
-}
-
-func (m *TxValue) GetDeliveryProof() *crypto.Proof {
-	if m != nil {
-		return m.DeliveryProof
-	}
-	return nil
+func (m *MsgSubmitQueryResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResultsResponse.DiscardUnknown(m)
 }
 
-func (m *TxValue) GetInclusionProof() *crypto.Proof {
-	if m != nil {
-		return m.InclusionProof
-	}
-	return nil
-}
+var xxx_messageInfo_MsgSubmitQueryResultsResponse proto.InternalMessageInfo
 
-func (m *TxValue) GetData() []byte {
+func (m *MsgSubmitQueryResultsResponse) GetResults() []QueryResultSubmission {
 	if m != nil {
-		return m.Data
+		return m.Results
 	}
 	return nil
 }
 
-type MsgSubmitQueryResultResponse struct {
+// QueryResultSubmission is the status of a single query result submitted within MsgSubmitQueryResults.
+type QueryResultSubmission struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// is the reason the result was rejected with if the submission has failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *MsgSubmitQueryResultResponse) Reset()         { *m = MsgSubmitQueryResultResponse{} }
-func (m *MsgSubmitQueryResultResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitQueryResultResponse) ProtoMessage()    {}
-func (*MsgSubmitQueryResultResponse) Descriptor() ([]byte, []int) {
+func (m *QueryResultSubmission) Reset()         { *m = QueryResultSubmission{} }
+func (m *QueryResultSubmission) String() string { return proto.CompactTextString(m) }
+func (*QueryResultSubmission) ProtoMessage()    {}
+func (*QueryResultSubmission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3f1f36ccf3a8e51d, []int{7}
 }
-func (m *MsgSubmitQueryResultResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryResultSubmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitQueryResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryResultSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitQueryResultResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryResultSubmission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -539,17 +524,38 @@ func (m *MsgSubmitQueryResultResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitQueryResultResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitQueryResultResponse.Merge(m, src)
+func (m *QueryResultSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResultSubmission.Merge(m, src)
 }
-func (m *MsgSubmitQueryResultResponse) XXX_Size() int {
+func (m *QueryResultSubmission) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitQueryResultResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitQueryResultResponse.DiscardUnknown(m)
+func (m *QueryResultSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResultSubmission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitQueryResultResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryResultSubmission proto.InternalMessageInfo
+
+func (m *QueryResultSubmission) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *QueryResultSubmission) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *QueryResultSubmission) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
 
 type MsgRemoveInterchainQueryRequest struct {
 	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
@@ -644,6 +650,10 @@ type MsgUpdateInterchainQueryRequest struct {
 	NewKeys         []*KVKey `protobuf:"bytes,2,rep,name=new_keys,json=newKeys,proto3" json:"new_keys,omitempty"`
 	NewUpdatePeriod uint64   `protobuf:"varint,3,opt,name=new_update_period,json=newUpdatePeriod,proto3" json:"new_update_period,omitempty"`
 	Sender          string   `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// is the new transactions filter of a TX query, its string values can't contain quotes
+	NewTransactionsFilter string `protobuf:"bytes,5,opt,name=new_transactions_filter,json=newTransactionsFilter,proto3" json:"new_transactions_filter,omitempty"`
+	// is the new connection of the query; the processed transactions of a TX query are forgotten on the change
+	NewConnectionId string `protobuf:"bytes,6,opt,name=new_connection_id,json=newConnectionId,proto3" json:"new_connection_id,omitempty"`
 }
 
 func (m *MsgUpdateInterchainQueryRequest) Reset()         { *m = MsgUpdateInterchainQueryRequest{} }
@@ -707,6 +717,20 @@ func (m *MsgUpdateInterchainQueryRequest) GetSender() string {
 	return ""
 }
 
+func (m *MsgUpdateInterchainQueryRequest) GetNewTransactionsFilter() string {
+	if m != nil {
+		return m.NewTransactionsFilter
+	}
+	return ""
+}
+
+func (m *MsgUpdateInterchainQueryRequest) GetNewConnectionId() string {
+	if m != nil {
+		return m.NewConnectionId
+	}
+	return ""
+}
+
 type MsgUpdateInterchainQueryResponse struct {
 }
 
@@ -743,177 +767,551 @@ func (m *MsgUpdateInterchainQueryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateInterchainQueryResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterInterchainQuery)(nil), "neutron.interchainadapter.interchainqueries.MsgRegisterInterchainQuery")
-	proto.RegisterType((*MsgRegisterInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgRegisterInterchainQueryResponse")
-	proto.RegisterType((*MsgSubmitQueryResult)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResult")
-	proto.RegisterType((*QueryResult)(nil), "neutron.interchainadapter.interchainqueries.QueryResult")
-	proto.RegisterType((*StorageValue)(nil), "neutron.interchainadapter.interchainqueries.StorageValue")
-	proto.RegisterType((*Block)(nil), "neutron.interchainadapter.interchainqueries.Block")
-	proto.RegisterType((*TxValue)(nil), "neutron.interchainadapter.interchainqueries.TxValue")
-	proto.RegisterType((*MsgSubmitQueryResultResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResultResponse")
-	proto.RegisterType((*MsgRemoveInterchainQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.MsgRemoveInterchainQueryRequest")
-	proto.RegisterType((*MsgRemoveInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgRemoveInterchainQueryResponse")
-	proto.RegisterType((*MsgUpdateInterchainQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.MsgUpdateInterchainQueryRequest")
-	proto.RegisterType((*MsgUpdateInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgUpdateInterchainQueryResponse")
+type MsgFundQueryReward struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// is the amount of coins added to the reward escrow of the query
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Sender string                                   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func init() { proto.RegisterFile("interchainqueries/tx.proto", fileDescriptor_3f1f36ccf3a8e51d) }
-
-var fileDescriptor_3f1f36ccf3a8e51d = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x76, 0xfe, 0xbc, 0x38, 0x49, 0x3b, 0x04, 0x70, 0x1d, 0xea, 0x46, 0x8b, 0x90,
-	0x22, 0x28, 0xbb, 0xc2, 0xf4, 0x50, 0x2e, 0x40, 0x4a, 0x55, 0x6a, 0x05, 0xab, 0x61, 0x9a, 0x56,
-	0x88, 0xcb, 0x6a, 0xbd, 0xfb, 0xb2, 0x1e, 0x79, 0x33, 0xbb, 0xdd, 0x99, 0xb5, 0xbd, 0xdf, 0xa2,
-	0x12, 0x12, 0x77, 0x3e, 0x03, 0x1c, 0xb8, 0x70, 0xe7, 0x82, 0xe8, 0x91, 0x23, 0x24, 0x5f, 0x04,
-	0xed, 0xcc, 0xda, 0x31, 0xb2, 0x5d, 0xb4, 0x6d, 0x6f, 0xfb, 0xde, 0xbc, 0xf7, 0x7b, 0x7f, 0xe6,
-	0xbd, 0xdf, 0x2c, 0x34, 0x19, 0x97, 0x98, 0x78, 0x7d, 0x97, 0xf1, 0x67, 0x29, 0x26, 0x0c, 0x85,
-	0x2d, 0xc7, 0x56, 0x9c, 0x44, 0x32, 0x22, 0x1f, 0x71, 0x4c, 0x65, 0x12, 0x71, 0xeb, 0xca, 0xc6,
-	0xf5, 0xdd, 0x58, 0x62, 0x62, 0xcd, 0x79, 0x35, 0x6f, 0x4a, 0xe4, 0x3e, 0x26, 0xe7, 0x8c, 0x4b,
-	0xdb, 0x4b, 0xb2, 0x58, 0x46, 0x76, 0x9c, 0x44, 0xd1, 0x99, 0xc6, 0x6a, 0xee, 0xcf, 0x1c, 0xbb,
-	0x3d, 0x8f, 0xd9, 0x32, 0x8b, 0x51, 0x14, 0x87, 0x37, 0x82, 0x28, 0x0a, 0x42, 0xb4, 0x95, 0xd4,
-	0x4b, 0xcf, 0x6c, 0x97, 0x67, 0xc5, 0xd1, 0xad, 0xf9, 0xfc, 0x02, 0xe4, 0x28, 0x58, 0xe1, 0x6b,
-	0xfe, 0x58, 0x81, 0x66, 0x57, 0x04, 0x14, 0x03, 0x26, 0x24, 0x26, 0x9d, 0xa9, 0xf9, 0xb7, 0x29,
-	0x26, 0x19, 0xb9, 0x09, 0x90, 0xfb, 0x65, 0x4e, 0x1e, 0xaf, 0x61, 0x1c, 0x18, 0x87, 0x9b, 0x74,
-	0x53, 0x69, 0x4e, 0xb3, 0x18, 0xc9, 0x03, 0xa8, 0x0e, 0x30, 0x13, 0x8d, 0xca, 0xc1, 0xea, 0xe1,
-	0x56, 0xbb, 0x6d, 0x95, 0xa8, 0xd8, 0x3a, 0x7e, 0x7a, 0x8c, 0x19, 0x55, 0xfe, 0xc4, 0x86, 0xb7,
-	0x64, 0xe2, 0x72, 0xe1, 0x7a, 0x92, 0x45, 0x5c, 0x38, 0x67, 0x2c, 0x94, 0x98, 0x34, 0x56, 0x55,
-	0x3c, 0x32, 0x7b, 0xf4, 0x40, 0x9d, 0x90, 0xf7, 0x61, 0xdb, 0x8b, 0x38, 0x47, 0xa5, 0x74, 0x98,
-	0xdf, 0xa8, 0x2a, 0xd3, 0xfa, 0x95, 0xb2, 0xe3, 0xe7, 0x46, 0x69, 0xec, 0xbb, 0x12, 0x9d, 0x18,
-	0x13, 0x16, 0xf9, 0x8d, 0xda, 0x81, 0x71, 0x58, 0xa5, 0x75, 0xad, 0x3c, 0x51, 0x3a, 0xf2, 0x0e,
-	0xac, 0x09, 0xd5, 0xdb, 0xc6, 0x9a, 0x82, 0x28, 0x24, 0xf3, 0x0e, 0x98, 0xcb, 0xfb, 0x42, 0x51,
-	0xc4, 0x11, 0x17, 0x48, 0x76, 0xa0, 0xc2, 0x7c, 0xd5, 0x97, 0x2a, 0xad, 0x30, 0xdf, 0xfc, 0xd5,
-	0x80, 0xbd, 0xae, 0x08, 0x1e, 0xa7, 0xbd, 0x73, 0x26, 0x27, 0xa6, 0x69, 0x28, 0xc9, 0x0d, 0xd8,
-	0xd0, 0x8d, 0x9c, 0x9a, 0xaf, 0x2b, 0xb9, 0x33, 0x9b, 0x41, 0x65, 0x36, 0x03, 0xb2, 0x0f, 0x9b,
-	0x5e, 0xc8, 0x90, 0xcb, 0xdc, 0x47, 0xb7, 0x62, 0x43, 0x2b, 0x3a, 0x3e, 0x39, 0x81, 0xb5, 0x44,
-	0x21, 0xab, 0xca, 0xb7, 0xda, 0x77, 0x4b, 0xf5, 0x7e, 0x26, 0x33, 0x5a, 0xe0, 0x98, 0x3f, 0x54,
-	0x60, 0x6b, 0x36, 0xe3, 0xef, 0x00, 0x06, 0x43, 0x47, 0x1f, 0x8a, 0x86, 0xa1, 0x6e, 0xf8, 0xb3,
-	0x52, 0x51, 0x1e, 0xcb, 0x28, 0x71, 0x03, 0x7c, 0xea, 0x86, 0x29, 0xd2, 0xcd, 0xc1, 0x50, 0x03,
-	0x0b, 0xf2, 0x10, 0x6a, 0xbd, 0x30, 0xf2, 0x06, 0xaa, 0xde, 0xb2, 0x63, 0x73, 0x2f, 0xf7, 0xa4,
-	0x1a, 0x20, 0x6f, 0x5d, 0x1f, 0x59, 0xd0, 0x97, 0xaa, 0x3f, 0x55, 0x5a, 0x48, 0xa4, 0x09, 0x1b,
-	0x09, 0x0e, 0x99, 0x60, 0x11, 0x57, 0xfd, 0xa9, 0xd2, 0xa9, 0x4c, 0x6e, 0x03, 0x71, 0xc3, 0x30,
-	0x1a, 0x39, 0x83, 0xa1, 0xe3, 0xb9, 0x61, 0xd8, 0x73, 0xbd, 0x81, 0x50, 0xa3, 0xb1, 0x41, 0xaf,
-	0xa9, 0x93, 0xe3, 0xe1, 0x57, 0x13, 0xbd, 0xf9, 0xdc, 0x80, 0xfa, 0x6c, 0x1d, 0xe4, 0x03, 0xd8,
-	0x11, 0x5a, 0x76, 0xe2, 0x04, 0xcf, 0xd8, 0xb8, 0xd8, 0x8a, 0xed, 0x42, 0x7b, 0xa2, 0x94, 0xe4,
-	0x1a, 0xac, 0x0e, 0x30, 0x53, 0x15, 0xd6, 0x69, 0xfe, 0x49, 0xf6, 0xa0, 0x36, 0xcc, 0x11, 0x54,
-	0xaa, 0x75, 0xaa, 0x05, 0xf2, 0x09, 0xd4, 0x4e, 0xf2, 0x3d, 0x2f, 0xae, 0x71, 0xdf, 0xba, 0x5a,
-	0x74, 0x4b, 0xf3, 0x80, 0xa5, 0xce, 0x1f, 0xc5, 0x82, 0x6a, 0x4b, 0xf3, 0x37, 0x03, 0x6a, 0xaa,
-	0x0b, 0xe4, 0x4b, 0xb8, 0xce, 0x71, 0x2c, 0x1d, 0xd5, 0x0c, 0xa7, 0x8f, 0x6e, 0x3e, 0x44, 0x86,
-	0x02, 0xda, 0xb3, 0x34, 0x29, 0x58, 0x13, 0x52, 0xb0, 0x8e, 0x78, 0x46, 0x77, 0x73, 0x73, 0xe5,
-	0xfb, 0x50, 0x19, 0x93, 0xdb, 0x79, 0x03, 0xdd, 0xc9, 0xec, 0x2d, 0x73, 0x2b, 0x6c, 0xc8, 0x7d,
-	0xa8, 0xc8, 0xb1, 0xca, 0x7f, 0xab, 0x7d, 0xa7, 0xd4, 0xad, 0x9d, 0x8e, 0xf5, 0x14, 0x54, 0xe4,
-	0xd8, 0xfc, 0xc7, 0x80, 0xf5, 0x42, 0x26, 0x9f, 0xe7, 0x17, 0xa5, 0x77, 0xa9, 0x48, 0xdc, 0x9c,
-	0xed, 0x40, 0x4e, 0x75, 0xd6, 0x64, 0xd9, 0xee, 0x63, 0xc8, 0x86, 0x98, 0x9c, 0x8e, 0xe9, 0xd4,
-	0x87, 0x7c, 0x01, 0x3b, 0xbe, 0x56, 0x67, 0x8e, 0xe2, 0xcb, 0xa2, 0x8e, 0xc6, 0xb2, 0x3e, 0xd2,
-	0xed, 0x89, 0xbd, 0x12, 0xc9, 0x11, 0xec, 0x32, 0xee, 0x85, 0x69, 0x3e, 0x1a, 0x05, 0xc2, 0xea,
-	0xff, 0x20, 0xec, 0x4c, 0x1d, 0x34, 0x04, 0x81, 0xaa, 0xef, 0x4a, 0x57, 0xdd, 0x60, 0x9d, 0xaa,
-	0x6f, 0xb3, 0x05, 0xef, 0x2d, 0xa2, 0x81, 0x49, 0x29, 0xe6, 0x29, 0xdc, 0x52, 0xec, 0x72, 0x1e,
-	0x0d, 0x71, 0x8e, 0x5b, 0x9e, 0xa5, 0x28, 0x5e, 0x85, 0x31, 0x4c, 0x13, 0x0e, 0x96, 0xa3, 0x16,
-	0x91, 0xff, 0x34, 0x54, 0xe8, 0x27, 0x8a, 0x03, 0xcb, 0x87, 0xee, 0xc2, 0x06, 0xc7, 0x91, 0xf3,
-	0x9a, 0xac, 0xbf, 0xce, 0x71, 0x74, 0x9c, 0x13, 0xff, 0x87, 0xf9, 0x04, 0x8f, 0x9c, 0xff, 0xd2,
-	0xb4, 0xde, 0xe5, 0x5d, 0x8e, 0xa3, 0x27, 0x8b, 0x99, 0xba, 0xba, 0xa0, 0xea, 0x25, 0x05, 0xe9,
-	0xaa, 0xdb, 0x7f, 0xd4, 0x60, 0xb5, 0x2b, 0x02, 0xf2, 0xb3, 0x01, 0xef, 0x2e, 0x7b, 0xeb, 0xbe,
-	0x2e, 0x55, 0xc8, 0xf2, 0xc7, 0xa1, 0xf9, 0xe8, 0x0d, 0x01, 0x4d, 0x5f, 0x99, 0x9f, 0x0c, 0xb8,
-	0x3e, 0xff, 0xa4, 0x1c, 0x95, 0x0d, 0x33, 0x07, 0xd1, 0xec, 0xbc, 0x36, 0xc4, 0x34, 0xc7, 0x5f,
-	0x0c, 0x78, 0x7b, 0xe1, 0xe4, 0x91, 0x6f, 0xca, 0xb7, 0x63, 0xf9, 0x5a, 0x34, 0xbb, 0x6f, 0x08,
-	0x6d, 0x26, 0xed, 0x85, 0xa3, 0x53, 0x3e, 0xed, 0x97, 0xad, 0x54, 0xf9, 0xb4, 0x5f, 0x3a, 0xcf,
-	0xf7, 0xe8, 0xef, 0x17, 0x2d, 0xe3, 0xc5, 0x45, 0xcb, 0xf8, 0xfb, 0xa2, 0x65, 0x3c, 0xbf, 0x6c,
-	0xad, 0xbc, 0xb8, 0x6c, 0xad, 0xfc, 0x75, 0xd9, 0x5a, 0xf9, 0xfe, 0x6e, 0xc0, 0x64, 0x3f, 0xed,
-	0x59, 0x5e, 0x74, 0x6e, 0x17, 0x21, 0x3f, 0x8e, 0x92, 0x60, 0xf2, 0x6d, 0x8f, 0xed, 0x05, 0xbf,
-	0xac, 0xf9, 0xcf, 0x64, 0x6f, 0x4d, 0x71, 0xfe, 0xa7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0x04, 0x8b, 0x56, 0xd4, 0x0a, 0x00, 0x00,
+func (m *MsgFundQueryReward) Reset()         { *m = MsgFundQueryReward{} }
+func (m *MsgFundQueryReward) String() string { return proto.CompactTextString(m) }
+func (*MsgFundQueryReward) ProtoMessage()    {}
+func (*MsgFundQueryReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error)
-	SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryResult, opts ...grpc.CallOption) (*MsgSubmitQueryResultResponse, error)
-	RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error)
-	UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInterchainQueryRequest, opts ...grpc.CallOption) (*MsgUpdateInterchainQueryResponse, error)
+func (m *MsgFundQueryReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgFundQueryReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundQueryReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgFundQueryReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundQueryReward.Merge(m, src)
 }
-
-func (c *msgClient) RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error) {
-	out := new(MsgRegisterInterchainQueryResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/RegisterInterchainQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgFundQueryReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundQueryReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundQueryReward.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryResult, opts ...grpc.CallOption) (*MsgSubmitQueryResultResponse, error) {
-	out := new(MsgSubmitQueryResultResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/SubmitQueryResult", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundQueryReward proto.InternalMessageInfo
+
+func (m *MsgFundQueryReward) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error) {
-	out := new(MsgRemoveInterchainQueryResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/RemoveInterchainQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFundQueryReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInterchainQueryRequest, opts ...grpc.CallOption) (*MsgUpdateInterchainQueryResponse, error) {
-	out := new(MsgUpdateInterchainQueryResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/UpdateInterchainQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFundQueryReward) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RegisterInterchainQuery(context.Context, *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error)
-	SubmitQueryResult(context.Context, *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error)
-	RemoveInterchainQuery(context.Context, *MsgRemoveInterchainQueryRequest) (*MsgRemoveInterchainQueryResponse, error)
-	UpdateInterchainQuery(context.Context, *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error)
+type MsgFundQueryRewardResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgFundQueryRewardResponse) Reset()         { *m = MsgFundQueryRewardResponse{} }
+func (m *MsgFundQueryRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundQueryRewardResponse) ProtoMessage()    {}
+func (*MsgFundQueryRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{13}
 }
-
-func (*UnimplementedMsgServer) RegisterInterchainQuery(ctx context.Context, req *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterchainQuery not implemented")
+func (m *MsgFundQueryRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubmitQueryResult(ctx context.Context, req *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResult not implemented")
+func (m *MsgFundQueryRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundQueryRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveInterchainQuery(ctx context.Context, req *MsgRemoveInterchainQueryRequest) (*MsgRemoveInterchainQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveInterchainQuery not implemented")
+func (m *MsgFundQueryRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundQueryRewardResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateInterchainQuery(ctx context.Context, req *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterchainQuery not implemented")
+func (m *MsgFundQueryRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundQueryRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundQueryRewardResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgFundQueryRewardResponse proto.InternalMessageInfo
+
+// MsgRetrySudoFailure passes the query result of a recorded sudo failure to the sudo handler of the current
+// query owner contract.
+type MsgRetrySudoFailure struct {
+	QueryId   uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	FailureId uint64 `protobuf:"varint,2,opt,name=failure_id,json=failureId,proto3" json:"failure_id,omitempty"`
+	Sender    string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRetrySudoFailure) Reset()         { *m = MsgRetrySudoFailure{} }
+func (m *MsgRetrySudoFailure) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrySudoFailure) ProtoMessage()    {}
+func (*MsgRetrySudoFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{14}
+}
+func (m *MsgRetrySudoFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrySudoFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrySudoFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetrySudoFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrySudoFailure.Merge(m, src)
+}
+func (m *MsgRetrySudoFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrySudoFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrySudoFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetrySudoFailure proto.InternalMessageInfo
+
+func (m *MsgRetrySudoFailure) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *MsgRetrySudoFailure) GetFailureId() uint64 {
+	if m != nil {
+		return m.FailureId
+	}
+	return 0
+}
+
+func (m *MsgRetrySudoFailure) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgRetrySudoFailureResponse struct {
+}
+
+func (m *MsgRetrySudoFailureResponse) Reset()         { *m = MsgRetrySudoFailureResponse{} }
+func (m *MsgRetrySudoFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrySudoFailureResponse) ProtoMessage()    {}
+func (*MsgRetrySudoFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{15}
+}
+func (m *MsgRetrySudoFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrySudoFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrySudoFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetrySudoFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrySudoFailureResponse.Merge(m, src)
+}
+func (m *MsgRetrySudoFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrySudoFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrySudoFailureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetrySudoFailureResponse proto.InternalMessageInfo
+
+// MsgTransferInterchainQueryOwnership makes another contract the owner of a query. The query keeps its id,
+// results, deposit and reward escrow.
+type MsgTransferInterchainQueryOwnership struct {
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// is the address of the contract becoming the owner of the query
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Sender   string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgTransferInterchainQueryOwnership) Reset()         { *m = MsgTransferInterchainQueryOwnership{} }
+func (m *MsgTransferInterchainQueryOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferInterchainQueryOwnership) ProtoMessage()    {}
+func (*MsgTransferInterchainQueryOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{16}
+}
+func (m *MsgTransferInterchainQueryOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainQueryOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainQueryOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainQueryOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainQueryOwnership.Merge(m, src)
+}
+func (m *MsgTransferInterchainQueryOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainQueryOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainQueryOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainQueryOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferInterchainQueryOwnership) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *MsgTransferInterchainQueryOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MsgTransferInterchainQueryOwnership) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgTransferInterchainQueryOwnershipResponse struct {
+}
+
+func (m *MsgTransferInterchainQueryOwnershipResponse) Reset() {
+	*m = MsgTransferInterchainQueryOwnershipResponse{}
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTransferInterchainQueryOwnershipResponse) ProtoMessage() {}
+func (*MsgTransferInterchainQueryOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f1f36ccf3a8e51d, []int{17}
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainQueryOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainQueryOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainQueryOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainQueryOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainQueryOwnershipResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterInterchainQuery)(nil), "neutron.interchainadapter.interchainqueries.MsgRegisterInterchainQuery")
+	proto.RegisterType((*MsgRegisterInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgRegisterInterchainQueryResponse")
+	proto.RegisterType((*MsgSubmitQueryResult)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResult")
+	proto.RegisterType((*MsgSubmitQueryResultResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResultResponse")
+	proto.RegisterType((*MsgSubmitQueryResults)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResults")
+	proto.RegisterType((*KVQueryResult)(nil), "neutron.interchainadapter.interchainqueries.KVQueryResult")
+	proto.RegisterType((*MsgSubmitQueryResultsResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgSubmitQueryResultsResponse")
+	proto.RegisterType((*QueryResultSubmission)(nil), "neutron.interchainadapter.interchainqueries.QueryResultSubmission")
+	proto.RegisterType((*MsgRemoveInterchainQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.MsgRemoveInterchainQueryRequest")
+	proto.RegisterType((*MsgRemoveInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgRemoveInterchainQueryResponse")
+	proto.RegisterType((*MsgUpdateInterchainQueryRequest)(nil), "neutron.interchainadapter.interchainqueries.MsgUpdateInterchainQueryRequest")
+	proto.RegisterType((*MsgUpdateInterchainQueryResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgUpdateInterchainQueryResponse")
+	proto.RegisterType((*MsgFundQueryReward)(nil), "neutron.interchainadapter.interchainqueries.MsgFundQueryReward")
+	proto.RegisterType((*MsgFundQueryRewardResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgFundQueryRewardResponse")
+	proto.RegisterType((*MsgRetrySudoFailure)(nil), "neutron.interchainadapter.interchainqueries.MsgRetrySudoFailure")
+	proto.RegisterType((*MsgRetrySudoFailureResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgRetrySudoFailureResponse")
+	proto.RegisterType((*MsgTransferInterchainQueryOwnership)(nil), "neutron.interchainadapter.interchainqueries.MsgTransferInterchainQueryOwnership")
+	proto.RegisterType((*MsgTransferInterchainQueryOwnershipResponse)(nil), "neutron.interchainadapter.interchainqueries.MsgTransferInterchainQueryOwnershipResponse")
+}
+
+func init() { proto.RegisterFile("interchainqueries/tx.proto", fileDescriptor_3f1f36ccf3a8e51d) }
+
+var fileDescriptor_3f1f36ccf3a8e51d = []byte{
+	// 1190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0x8d, 0x01, 0xfb, 0x91, 0x34, 0xb0, 0x40, 0x63, 0x96, 0x60, 0xd0, 0xe6, 0x82, 0x9a,
+	0xb0, 0xdb, 0xd0, 0xaa, 0x4a, 0x7b, 0x69, 0x03, 0x12, 0x89, 0x4b, 0xad, 0xd0, 0x85, 0xa0, 0x28,
+	0x17, 0x6b, 0xbd, 0xfb, 0x58, 0xaf, 0x6c, 0xcf, 0x38, 0x3b, 0xb3, 0x36, 0xce, 0xb5, 0x3d, 0xf5,
+	0xd4, 0x7b, 0xa5, 0x4a, 0x95, 0x7a, 0xaa, 0x7a, 0x6a, 0x7a, 0xe8, 0x47, 0xc8, 0xa9, 0xca, 0xb1,
+	0xa7, 0xb6, 0x82, 0x7b, 0x3f, 0x43, 0xb5, 0xb3, 0x7f, 0xb0, 0xb1, 0x4d, 0xb2, 0x31, 0x27, 0x3c,
+	0xf3, 0xde, 0xfc, 0xde, 0xef, 0xfd, 0x5f, 0x40, 0x71, 0x09, 0x47, 0xcf, 0xaa, 0x99, 0x2e, 0x79,
+	0xee, 0xa3, 0xe7, 0x22, 0xd3, 0xf9, 0x89, 0xd6, 0xf2, 0x28, 0xa7, 0xf2, 0x1d, 0x82, 0x3e, 0xf7,
+	0x28, 0xd1, 0xce, 0x75, 0x4c, 0xdb, 0x6c, 0x71, 0xf4, 0xb4, 0x81, 0x57, 0xca, 0xa2, 0x43, 0x1d,
+	0x2a, 0xde, 0xe9, 0xc1, 0xaf, 0x10, 0x42, 0x29, 0x5a, 0x94, 0x35, 0x29, 0xd3, 0xab, 0x26, 0x43,
+	0xbd, 0x7d, 0xaf, 0x8a, 0xdc, 0xbc, 0xa7, 0x5b, 0xd4, 0x25, 0x91, 0x7c, 0x6d, 0xd0, 0xbc, 0x83,
+	0x04, 0x99, 0xcb, 0x22, 0x85, 0x65, 0x87, 0x52, 0xa7, 0x81, 0xba, 0x38, 0x55, 0xfd, 0x63, 0xdd,
+	0x24, 0xdd, 0x50, 0xa4, 0xfe, 0x37, 0x09, 0x4a, 0x99, 0x39, 0x06, 0x3a, 0x2e, 0xe3, 0xe8, 0x95,
+	0x12, 0xa4, 0xaf, 0x7d, 0xf4, 0xba, 0xf2, 0x2a, 0x40, 0x00, 0xd9, 0xad, 0xf0, 0x6e, 0x0b, 0x0b,
+	0xd2, 0xba, 0xb4, 0x91, 0x37, 0xf2, 0xe2, 0xe6, 0xb0, 0xdb, 0x42, 0x79, 0x17, 0xb2, 0x75, 0xec,
+	0xb2, 0x42, 0x66, 0x7d, 0x72, 0x63, 0x76, 0x6b, 0x4b, 0x4b, 0xe1, 0xab, 0xb6, 0x77, 0xb4, 0x87,
+	0x5d, 0x43, 0xbc, 0x97, 0x75, 0x58, 0xe0, 0x9e, 0x49, 0x98, 0x69, 0x71, 0x97, 0x12, 0x56, 0x39,
+	0x76, 0x1b, 0x1c, 0xbd, 0xc2, 0xa4, 0xb0, 0x27, 0xf7, 0x8a, 0x76, 0x85, 0x44, 0xbe, 0x0d, 0xd7,
+	0x2d, 0x4a, 0x08, 0x8a, 0xcb, 0x8a, 0x6b, 0x17, 0xb2, 0x42, 0xf5, 0xda, 0xf9, 0x65, 0xc9, 0x0e,
+	0x94, 0xfc, 0x96, 0x6d, 0x72, 0xac, 0xb4, 0xd0, 0x73, 0xa9, 0x5d, 0x98, 0x5a, 0x97, 0x36, 0xb2,
+	0xc6, 0xb5, 0xf0, 0x72, 0x5f, 0xdc, 0xc9, 0xef, 0xc3, 0x34, 0x43, 0x62, 0xa3, 0x57, 0x98, 0x16,
+	0x10, 0xd1, 0x49, 0x3e, 0x81, 0x79, 0xe6, 0x57, 0x9b, 0x2e, 0x63, 0x81, 0x05, 0x0f, 0x3b, 0xa6,
+	0x67, 0x17, 0x66, 0x84, 0x9f, 0xcb, 0x5a, 0x98, 0x10, 0x2d, 0x48, 0x88, 0x16, 0x25, 0x44, 0xdb,
+	0xa1, 0x2e, 0xd9, 0xfe, 0xf0, 0xd5, 0xdf, 0x6b, 0x13, 0xbf, 0xfc, 0xb3, 0xb6, 0xe1, 0xb8, 0xbc,
+	0xe6, 0x57, 0x35, 0x8b, 0x36, 0xf5, 0x28, 0x7b, 0xe1, 0x9f, 0x4d, 0x66, 0xd7, 0xf5, 0x20, 0x98,
+	0x4c, 0x3c, 0x60, 0xc6, 0xdc, 0xb9, 0x15, 0x43, 0x18, 0x91, 0x35, 0x58, 0xf0, 0x90, 0xf9, 0x0d,
+	0x5e, 0xa9, 0xb9, 0x8c, 0x53, 0xaf, 0x5b, 0x61, 0xee, 0x0b, 0x2c, 0xe4, 0x04, 0xf9, 0xf9, 0x50,
+	0xf4, 0x28, 0x94, 0x1c, 0xb8, 0x2f, 0x50, 0xbe, 0x0b, 0x32, 0x3f, 0xa9, 0x34, 0x91, 0x31, 0xd3,
+	0xc1, 0x24, 0x76, 0x79, 0xe1, 0xcd, 0x1c, 0x3f, 0x29, 0x47, 0x82, 0x30, 0x72, 0xea, 0xc7, 0xa0,
+	0x8e, 0xce, 0xb7, 0x81, 0xac, 0x45, 0x09, 0x43, 0xf9, 0x3d, 0xc8, 0xb8, 0xb6, 0xc8, 0x77, 0xd6,
+	0xc8, 0xb8, 0xb6, 0xfa, 0x87, 0x04, 0x8b, 0x65, 0xe6, 0x1c, 0x04, 0x5c, 0x79, 0xac, 0xea, 0x37,
+	0xb8, 0xbc, 0x0c, 0xb9, 0xb0, 0x40, 0x12, 0xf5, 0x19, 0x71, 0x2e, 0xf5, 0x46, 0x36, 0xd3, 0x17,
+	0xd9, 0x15, 0xc8, 0x5b, 0x0d, 0x17, 0x09, 0x0f, 0xde, 0x84, 0x29, 0xce, 0x85, 0x17, 0x25, 0x5b,
+	0xde, 0x87, 0xe9, 0xd0, 0x43, 0x91, 0xd1, 0xd9, 0xad, 0xfb, 0xa9, 0x6a, 0xaa, 0x87, 0x99, 0x11,
+	0xe1, 0xa8, 0x45, 0xb8, 0x35, 0x8c, 0x79, 0xec, 0xaa, 0xfa, 0x5d, 0x06, 0x96, 0x86, 0x29, 0xb0,
+	0x1e, 0x07, 0xa4, 0xd1, 0x0e, 0x64, 0x2e, 0x38, 0xa0, 0x40, 0xce, 0xc3, 0xb6, 0x1b, 0xe4, 0x53,
+	0x38, 0x97, 0x35, 0x92, 0x73, 0x00, 0x58, 0x43, 0xd7, 0xa9, 0x85, 0xce, 0x65, 0x8d, 0xe8, 0x24,
+	0x3f, 0x83, 0x99, 0x90, 0x2c, 0x2b, 0x4c, 0x89, 0x0a, 0xfb, 0x2c, 0x65, 0x27, 0xf5, 0xd0, 0xde,
+	0xce, 0x06, 0x25, 0x68, 0xc4, 0x80, 0xf2, 0xdd, 0xc0, 0xa6, 0x19, 0xd7, 0xf7, 0xec, 0xd6, 0xa2,
+	0x16, 0x0e, 0x03, 0x2d, 0x1e, 0x06, 0xda, 0x03, 0xd2, 0x35, 0x22, 0x1d, 0xf5, 0x37, 0x09, 0xae,
+	0xef, 0x1d, 0xbd, 0x65, 0x82, 0x9f, 0x02, 0xd4, 0xdb, 0x95, 0x98, 0x79, 0x38, 0x03, 0x3e, 0x4d,
+	0xc5, 0xfc, 0x80, 0x53, 0xcf, 0x74, 0xf0, 0xc8, 0x6c, 0xf8, 0x68, 0xe4, 0xeb, 0x6d, 0x23, 0x21,
+	0x2d, 0x9b, 0x8d, 0x06, 0xed, 0x54, 0xea, 0xed, 0x8a, 0x65, 0x36, 0x1a, 0x55, 0xd3, 0xaa, 0x33,
+	0x11, 0xce, 0x9c, 0x31, 0x27, 0x24, 0x7b, 0xed, 0x9d, 0xf8, 0x5e, 0xfd, 0x46, 0x82, 0xd5, 0xa1,
+	0x19, 0x4c, 0xca, 0xb9, 0x7a, 0x1e, 0x60, 0x49, 0xd0, 0xdc, 0x7e, 0xd7, 0xb2, 0x3a, 0x48, 0xba,
+	0xf5, 0x42, 0xa0, 0xd5, 0x2a, 0x2c, 0x0d, 0xd5, 0xbb, 0x2c, 0x82, 0x05, 0x98, 0x61, 0xbe, 0x65,
+	0x21, 0x63, 0xa2, 0x8e, 0x72, 0x46, 0x7c, 0x94, 0x17, 0x61, 0x0a, 0x3d, 0x8f, 0xc6, 0x33, 0x30,
+	0x3c, 0xa8, 0x87, 0xb0, 0x26, 0x9a, 0xb7, 0x49, 0xdb, 0x38, 0xd0, 0xba, 0xcf, 0x7d, 0x64, 0xef,
+	0xd2, 0x90, 0xaa, 0x0a, 0xeb, 0xa3, 0x51, 0xa3, 0x2e, 0x79, 0x99, 0x11, 0xa6, 0x9f, 0x88, 0xd1,
+	0x99, 0xde, 0x74, 0x19, 0x72, 0x04, 0x3b, 0x95, 0x31, 0x97, 0xc5, 0x0c, 0xc1, 0xce, 0x5e, 0xb0,
+	0x2f, 0x3e, 0x80, 0xf9, 0x00, 0xae, 0x7f, 0xba, 0x87, 0xdd, 0x76, 0x83, 0x60, 0xe7, 0xc9, 0xf0,
+	0x01, 0x9f, 0xed, 0xeb, 0xe2, 0x4f, 0xe0, 0x66, 0x80, 0x31, 0x6c, 0xef, 0x4c, 0x09, 0xc5, 0x25,
+	0x82, 0x9d, 0xc3, 0xc1, 0xd5, 0x13, 0xd9, 0xee, 0x5f, 0x3f, 0xe1, 0xee, 0x08, 0x6c, 0xef, 0xf4,
+	0x6c, 0xa0, 0x28, 0xb2, 0x23, 0x82, 0x16, 0x45, 0xf6, 0x57, 0x09, 0xe4, 0x32, 0x73, 0x76, 0x7d,
+	0x62, 0x47, 0x02, 0xb1, 0x05, 0x2e, 0x09, 0xa6, 0x05, 0xd3, 0x66, 0x93, 0xfa, 0x84, 0x17, 0x32,
+	0x57, 0xbf, 0x8f, 0x22, 0xe8, 0x9e, 0xb0, 0x4d, 0xf6, 0x15, 0xcb, 0x2d, 0x50, 0x06, 0xd9, 0x26,
+	0xce, 0x38, 0xb0, 0x20, 0x4a, 0x89, 0x7b, 0xdd, 0x03, 0xdf, 0xa6, 0xbb, 0xa6, 0xdb, 0xf0, 0x3d,
+	0xbc, 0xcc, 0x99, 0x55, 0x80, 0xe3, 0x50, 0x2b, 0x9e, 0xa6, 0x59, 0x23, 0x1f, 0xdd, 0x94, 0xec,
+	0x91, 0x34, 0x56, 0x61, 0x65, 0x88, 0xa1, 0x84, 0x87, 0x0f, 0xb7, 0xcb, 0xcc, 0x11, 0xd9, 0x3b,
+	0x1e, 0xd8, 0x72, 0x8f, 0x3b, 0x04, 0x3d, 0x56, 0x73, 0x5b, 0x97, 0xf1, 0x5a, 0x81, 0x7c, 0x90,
+	0x66, 0x1a, 0xe8, 0xc6, 0x43, 0x9e, 0x60, 0x47, 0xbc, 0x1d, 0xc9, 0x6a, 0x13, 0xee, 0xbc, 0x85,
+	0xd9, 0x98, 0xe5, 0xd6, 0xb7, 0xb3, 0x30, 0x59, 0x66, 0x8e, 0xfc, 0x52, 0x82, 0x9b, 0xa3, 0xbe,
+	0xc0, 0x1e, 0xa6, 0xea, 0x93, 0xd1, 0xab, 0x5d, 0x79, 0x7c, 0x45, 0x40, 0xc9, 0x50, 0xfd, 0x49,
+	0x82, 0xf9, 0xc1, 0x0f, 0x82, 0x07, 0x69, 0xcd, 0x0c, 0x40, 0x28, 0xa5, 0xb1, 0x21, 0x12, 0x8e,
+	0x3f, 0x4b, 0x20, 0x0f, 0xd9, 0xec, 0xdb, 0x63, 0x5b, 0x60, 0xca, 0x97, 0xe3, 0x63, 0x24, 0x34,
+	0x7f, 0x97, 0x60, 0x69, 0xe8, 0xfc, 0x95, 0xbf, 0x4a, 0x9f, 0xb5, 0xd1, 0xcb, 0x41, 0x29, 0x5f,
+	0x11, 0x5a, 0x0f, 0xed, 0xa1, 0xc3, 0x2d, 0x3d, 0xed, 0xcb, 0x16, 0x8b, 0x52, 0xbe, 0x22, 0xb4,
+	0x88, 0xf6, 0x0f, 0x12, 0xdc, 0xb8, 0x38, 0x6e, 0x3f, 0x4f, 0x6b, 0xe2, 0x02, 0x80, 0xf2, 0x70,
+	0x4c, 0x80, 0x84, 0xdd, 0x8f, 0x12, 0xcc, 0x0d, 0x0c, 0xd0, 0x2f, 0xd2, 0x27, 0xae, 0x1f, 0x41,
+	0x79, 0x34, 0x2e, 0x42, 0x42, 0xf0, 0x4f, 0x09, 0xd6, 0xdf, 0x38, 0x59, 0xf7, 0xd3, 0x9a, 0x7b,
+	0x13, 0xa2, 0xf2, 0xf4, 0xaa, 0x11, 0x63, 0x87, 0xb6, 0x8d, 0x57, 0xa7, 0x45, 0xe9, 0xf5, 0x69,
+	0x51, 0xfa, 0xf7, 0xb4, 0x28, 0x7d, 0x7f, 0x56, 0x9c, 0x78, 0x7d, 0x56, 0x9c, 0xf8, 0xeb, 0xac,
+	0x38, 0xf1, 0xec, 0x7e, 0xcf, 0xda, 0x8c, 0xac, 0x6f, 0x52, 0xcf, 0x89, 0x7f, 0xeb, 0x27, 0xfa,
+	0x90, 0xff, 0xfc, 0x83, 0x65, 0x5a, 0x9d, 0x16, 0x9f, 0xd7, 0x1f, 0xfd, 0x3f, 0x00, 0x53, 0x78,
+	0x1a, 0x15, 0x1b, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error)
+	SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryResult, opts ...grpc.CallOption) (*MsgSubmitQueryResultResponse, error)
+	SubmitQueryResults(ctx context.Context, in *MsgSubmitQueryResults, opts ...grpc.CallOption) (*MsgSubmitQueryResultsResponse, error)
+	RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error)
+	UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInterchainQueryRequest, opts ...grpc.CallOption) (*MsgUpdateInterchainQueryResponse, error)
+	FundQueryReward(ctx context.Context, in *MsgFundQueryReward, opts ...grpc.CallOption) (*MsgFundQueryRewardResponse, error)
+	RetrySudoFailure(ctx context.Context, in *MsgRetrySudoFailure, opts ...grpc.CallOption) (*MsgRetrySudoFailureResponse, error)
+	TransferInterchainQueryOwnership(ctx context.Context, in *MsgTransferInterchainQueryOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainQueryOwnershipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterInterchainQuery(ctx context.Context, in *MsgRegisterInterchainQuery, opts ...grpc.CallOption) (*MsgRegisterInterchainQueryResponse, error) {
+	out := new(MsgRegisterInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/RegisterInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryResult, opts ...grpc.CallOption) (*MsgSubmitQueryResultResponse, error) {
+	out := new(MsgSubmitQueryResultResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/SubmitQueryResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitQueryResults(ctx context.Context, in *MsgSubmitQueryResults, opts ...grpc.CallOption) (*MsgSubmitQueryResultsResponse, error) {
+	out := new(MsgSubmitQueryResultsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/SubmitQueryResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error) {
+	out := new(MsgRemoveInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/RemoveInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInterchainQueryRequest, opts ...grpc.CallOption) (*MsgUpdateInterchainQueryResponse, error) {
+	out := new(MsgUpdateInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/UpdateInterchainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundQueryReward(ctx context.Context, in *MsgFundQueryReward, opts ...grpc.CallOption) (*MsgFundQueryRewardResponse, error) {
+	out := new(MsgFundQueryRewardResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/FundQueryReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetrySudoFailure(ctx context.Context, in *MsgRetrySudoFailure, opts ...grpc.CallOption) (*MsgRetrySudoFailureResponse, error) {
+	out := new(MsgRetrySudoFailureResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/RetrySudoFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferInterchainQueryOwnership(ctx context.Context, in *MsgTransferInterchainQueryOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainQueryOwnershipResponse, error) {
+	out := new(MsgTransferInterchainQueryOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainadapter.interchainqueries.Msg/TransferInterchainQueryOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterInterchainQuery(context.Context, *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error)
+	SubmitQueryResult(context.Context, *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error)
+	SubmitQueryResults(context.Context, *MsgSubmitQueryResults) (*MsgSubmitQueryResultsResponse, error)
+	RemoveInterchainQuery(context.Context, *MsgRemoveInterchainQueryRequest) (*MsgRemoveInterchainQueryResponse, error)
+	UpdateInterchainQuery(context.Context, *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error)
+	FundQueryReward(context.Context, *MsgFundQueryReward) (*MsgFundQueryRewardResponse, error)
+	RetrySudoFailure(context.Context, *MsgRetrySudoFailure) (*MsgRetrySudoFailureResponse, error)
+	TransferInterchainQueryOwnership(context.Context, *MsgTransferInterchainQueryOwnership) (*MsgTransferInterchainQueryOwnershipResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterInterchainQuery(ctx context.Context, req *MsgRegisterInterchainQuery) (*MsgRegisterInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterchainQuery not implemented")
+}
+func (*UnimplementedMsgServer) SubmitQueryResult(ctx context.Context, req *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResult not implemented")
+}
+func (*UnimplementedMsgServer) SubmitQueryResults(ctx context.Context, req *MsgSubmitQueryResults) (*MsgSubmitQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResults not implemented")
+}
+func (*UnimplementedMsgServer) RemoveInterchainQuery(ctx context.Context, req *MsgRemoveInterchainQueryRequest) (*MsgRemoveInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInterchainQuery not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInterchainQuery(ctx context.Context, req *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterchainQuery not implemented")
+}
+func (*UnimplementedMsgServer) FundQueryReward(ctx context.Context, req *MsgFundQueryReward) (*MsgFundQueryRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundQueryReward not implemented")
+}
+func (*UnimplementedMsgServer) RetrySudoFailure(ctx context.Context, req *MsgRetrySudoFailure) (*MsgRetrySudoFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySudoFailure not implemented")
+}
+func (*UnimplementedMsgServer) TransferInterchainQueryOwnership(ctx context.Context, req *MsgTransferInterchainQueryOwnership) (*MsgTransferInterchainQueryOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferInterchainQueryOwnership not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
 func _Msg_RegisterInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -952,8 +1350,26 @@ func _Msg_SubmitQueryResult_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveInterchainQueryRequest)
+func _Msg_SubmitQueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitQueryResults)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitQueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Msg/SubmitQueryResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitQueryResults(ctx, req.(*MsgSubmitQueryResults))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveInterchainQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -988,6 +1404,60 @@ func _Msg_UpdateInterchainQuery_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundQueryReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundQueryReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundQueryReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Msg/FundQueryReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundQueryReward(ctx, req.(*MsgFundQueryReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetrySudoFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetrySudoFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetrySudoFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Msg/RetrySudoFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetrySudoFailure(ctx, req.(*MsgRetrySudoFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferInterchainQueryOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferInterchainQueryOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferInterchainQueryOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainadapter.interchainqueries.Msg/TransferInterchainQueryOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferInterchainQueryOwnership(ctx, req.(*MsgTransferInterchainQueryOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.interchainadapter.interchainqueries.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1000,6 +1470,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResult",
 			Handler:    _Msg_SubmitQueryResult_Handler,
 		},
+		{
+			MethodName: "SubmitQueryResults",
+			Handler:    _Msg_SubmitQueryResults_Handler,
+		},
 		{
 			MethodName: "RemoveInterchainQuery",
 			Handler:    _Msg_RemoveInterchainQuery_Handler,
@@ -1008,6 +1482,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInterchainQuery",
 			Handler:    _Msg_UpdateInterchainQuery_Handler,
 		},
+		{
+			MethodName: "FundQueryReward",
+			Handler:    _Msg_FundQueryReward_Handler,
+		},
+		{
+			MethodName: "RetrySudoFailure",
+			Handler:    _Msg_RetrySudoFailure_Handler,
+		},
+		{
+			MethodName: "TransferInterchainQueryOwnership",
+			Handler:    _Msg_TransferInterchainQueryOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "interchainqueries/tx.proto",
@@ -1033,6 +1519,32 @@ func (m *MsgRegisterInterchainQuery) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.TxMessagesFilter) > 0 {
+		i -= len(m.TxMessagesFilter)
+		copy(dAtA[i:], m.TxMessagesFilter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxMessagesFilter)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ResultHistorySize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ResultHistorySize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SubmissionReward) > 0 {
+		for iNdEx := len(m.SubmissionReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubmissionReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1165,7 +1677,7 @@ func (m *MsgSubmitQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitQueryResultResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1175,66 +1687,20 @@ func (m *QueryResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AllowKvCallbacks {
-		i--
-		if m.AllowKvCallbacks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Revision != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Revision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.KvResults) > 0 {
-		for iNdEx := len(m.KvResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.KvResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StorageValue) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitQueryResults) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1244,19 +1710,19 @@ func (m *StorageValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResults) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Proof != nil {
+	if m.Header != nil {
 		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1264,33 +1730,50 @@ func (m *StorageValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
+	}
+	if m.Revision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.StoragePrefix) > 0 {
-		i -= len(m.StoragePrefix)
-		copy(dAtA[i:], m.StoragePrefix)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StoragePrefix)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
+func (m *KVQueryResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1300,56 +1783,49 @@ func (m *Block) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+func (m *KVQueryResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KVQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.AllowKvCallbacks {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+		if m.AllowKvCallbacks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.NextBlockHeader != nil {
-		{
-			size, err := m.NextBlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.KvResults) > 0 {
+		for iNdEx := len(m.KvResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KvResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxValue) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitQueryResultsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1359,63 +1835,34 @@ func (m *TxValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResultsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitQueryResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.InclusionProof != nil {
-		{
-			size, err := m.InclusionProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DeliveryProof != nil {
-		{
-			size, err := m.DeliveryProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Response != nil {
-		{
-			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitQueryResultResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryResultSubmission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1425,16 +1872,38 @@ func (m *MsgSubmitQueryResultResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitQueryResultResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryResultSubmission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryResultSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1516,7 +1985,21 @@ func (m *MsgUpdateInterchainQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
+	if len(m.NewConnectionId) > 0 {
+		i -= len(m.NewConnectionId)
+		copy(dAtA[i:], m.NewConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewConnectionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NewTransactionsFilter) > 0 {
+		i -= len(m.NewTransactionsFilter)
+		copy(dAtA[i:], m.NewTransactionsFilter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewTransactionsFilter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
@@ -1573,6 +2056,206 @@ func (m *MsgUpdateInterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundQueryReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundQueryReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundQueryReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundQueryRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundQueryRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundQueryRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrySudoFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrySudoFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrySudoFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailureId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailureId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrySudoFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrySudoFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrySudoFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferInterchainQueryOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainQueryOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainQueryOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferInterchainQueryOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainQueryOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainQueryOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1615,6 +2298,19 @@ func (m *MsgRegisterInterchainQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.SubmissionReward) > 0 {
+		for _, e := range m.SubmissionReward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ResultHistorySize != 0 {
+		n += 1 + sovTx(uint64(m.ResultHistorySize))
+	}
+	l = len(m.TxMessagesFilter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1654,111 +2350,100 @@ func (m *MsgSubmitQueryResult) Size() (n int) {
 	return n
 }
 
-func (m *QueryResult) Size() (n int) {
+func (m *MsgSubmitQueryResultResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.KvResults) > 0 {
-		for _, e := range m.KvResults {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	if m.Revision != 0 {
-		n += 1 + sovTx(uint64(m.Revision))
-	}
-	if m.AllowKvCallbacks {
-		n += 2
-	}
 	return n
 }
 
-func (m *StorageValue) Size() (n int) {
+func (m *MsgSubmitQueryResults) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.StoragePrefix)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Key)
+	l = len(m.ClientId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Revision != 0 {
+		n += 1 + sovTx(uint64(m.Revision))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *Block) Size() (n int) {
+func (m *KVQueryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NextBlockHeader != nil {
-		l = m.NextBlockHeader.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.KvResults) > 0 {
+		for _, e := range m.KvResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.AllowKvCallbacks {
+		n += 2
 	}
 	return n
 }
 
-func (m *TxValue) Size() (n int) {
+func (m *MsgSubmitQueryResultsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Response != nil {
-		l = m.Response.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeliveryProof != nil {
-		l = m.DeliveryProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.InclusionProof != nil {
-		l = m.InclusionProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgSubmitQueryResultResponse) Size() (n int) {
+func (m *QueryResultSubmission) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1802,32 +2487,732 @@ func (m *MsgUpdateInterchainQueryRequest) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.NewUpdatePeriod != 0 {
-		n += 1 + sovTx(uint64(m.NewUpdatePeriod))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	if m.NewUpdatePeriod != 0 {
+		n += 1 + sovTx(uint64(m.NewUpdatePeriod))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewTransactionsFilter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInterchainQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundQueryReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundQueryRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetrySudoFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if m.FailureId != 0 {
+		n += 1 + sovTx(uint64(m.FailureId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetrySudoFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferInterchainQueryOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferInterchainQueryOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &KVKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionsFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+			}
+			m.UpdatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmissionReward = append(m.SubmissionReward, types.Coin{})
+			if err := m.SubmissionReward[len(m.SubmissionReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultHistorySize", wireType)
+			}
+			m.ResultHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResultHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxMessagesFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxMessagesFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &QueryResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateInterchainQueryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitQueryResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,15 +3235,15 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitQueryResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitQueryResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1886,13 +3271,13 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1902,31 +3287,67 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &KVKey{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1936,29 +3357,31 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionsFilter = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, KVQueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1968,29 +3391,83 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &types1.Any{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			m.UpdatePeriod = 0
+			m.QueryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2000,16 +3477,16 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatePeriod |= uint64(b&0x7F) << shift
+				m.QueryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KvResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2019,24 +3496,46 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.KvResults = append(m.KvResults, &StorageValue{})
+			if err := m.KvResults[len(m.KvResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowKvCallbacks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowKvCallbacks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2058,7 +3557,7 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitQueryResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2081,17 +3580,17 @@ func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitQueryResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitQueryResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2101,11 +3600,26 @@ func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, QueryResultSubmission{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2127,7 +3641,7 @@ func (m *MsgRegisterInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
+func (m *QueryResultSubmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2150,10 +3664,10 @@ func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResultSubmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResultSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2176,10 +3690,10 @@ func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2189,27 +3703,15 @@ func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2237,43 +3739,7 @@ func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &QueryResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2296,7 +3762,7 @@ func (m *MsgSubmitQueryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResult) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2319,17 +3785,17 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KvResults", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			var msglen int
+			m.QueryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2339,31 +3805,16 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.QueryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KvResults = append(m.KvResults, &StorageValue{})
-			if err := m.KvResults[len(m.KvResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2373,86 +3824,74 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Revision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Revision |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowKvCallbacks", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AllowKvCallbacks = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2474,7 +3913,7 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageValue) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2497,17 +3936,36 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateInterchainQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoragePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2517,29 +3975,50 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoragePrefix = string(dAtA[iNdEx:postIndex])
+			m.NewKeys = append(m.NewKeys, &KVKey{})
+			if err := m.NewKeys[len(m.NewKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUpdatePeriod", wireType)
+			}
+			m.NewUpdatePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewUpdatePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2549,31 +4028,29 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTransactionsFilter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2583,31 +4060,29 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.NewTransactionsFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2617,27 +4092,23 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &crypto.ProofOps{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2660,7 +4131,7 @@ func (m *StorageValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Block) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2683,120 +4154,12 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateInterchainQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextBlockHeader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NextBlockHeader == nil {
-				m.NextBlockHeader = &types.Any{}
-			}
-			if err := m.NextBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &types.Any{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tx == nil {
-				m.Tx = &TxValue{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2818,7 +4181,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxValue) Unmarshal(dAtA []byte) error {
+func (m *MsgFundQueryReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2841,17 +4204,17 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundQueryReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundQueryReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
-			var msglen int
+			m.QueryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2861,31 +4224,14 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.QueryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &types1.ResponseDeliverTx{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2912,54 +4258,16 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeliveryProof == nil {
-				m.DeliveryProof = &crypto.Proof{}
-			}
-			if err := m.DeliveryProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProof", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InclusionProof == nil {
-				m.InclusionProof = &crypto.Proof{}
-			}
-			if err := m.InclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2969,25 +4277,23 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3010,7 +4316,7 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitQueryResultResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundQueryRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3033,10 +4339,10 @@ func (m *MsgSubmitQueryResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitQueryResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundQueryRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitQueryResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundQueryRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3060,7 +4366,7 @@ func (m *MsgSubmitQueryResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRetrySudoFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,10 +4389,10 @@ func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetrySudoFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetrySudoFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3109,6 +4415,25 @@ func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+			}
+			m.FailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -3161,7 +4486,7 @@ func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetrySudoFailureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3184,10 +4509,10 @@ func (m *MsgRemoveInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetrySudoFailureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetrySudoFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3211,7 +4536,7 @@ func (m *MsgRemoveInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferInterchainQueryOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3234,10 +4559,10 @@ func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterchainQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferInterchainQueryOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferInterchainQueryOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3261,9 +4586,9 @@ func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3273,46 +4598,25 @@ func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewKeys = append(m.NewKeys, &KVKey{})
-			if err := m.NewKeys[len(m.NewKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewUpdatePeriod", wireType)
-			}
-			m.NewUpdatePeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewUpdatePeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -3365,7 +4669,7 @@ func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterchainQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferInterchainQueryOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3388,10 +4692,10 @@ func (m *MsgUpdateInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterchainQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferInterchainQueryOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterchainQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferInterchainQueryOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// TxMessagesFilter is the structured filter the messages of the transactions submitted for a TX query are
+// verified against on chain. A transaction matches the filter if any of its messages matches any of the
+// filter items.
+type TxMessagesFilter []TxMessageFilter
+
+// TxMessageFilter describes the messages of a single type.
+type TxMessageFilter struct {
+	// TypeUrl is the type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend.
+	TypeUrl string `json:"type_url"`
+	// Fields are the conditions all of which must be met by the message fields.
+	Fields []TxMessageFieldCondition `json:"fields,omitempty"`
+}
+
+// TxMessageFieldCondition is a single condition for a message field.
+type TxMessageFieldCondition struct {
+	// Field is the dot-separated path to the field in the JSON representation of the message, e.g.
+	// to_address or amount.denom. Every element of a repeated field is checked on the way.
+	Field string `json:"field"`
+	// Op is the operation for filtering, one of the following: eq, contains, exists.
+	Op string `json:"op"`
+	// Value is the value for comparison. It must be omitted for the exists operation.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ParseTxMessagesFilter unmarshals and validates the passed TxMessagesFilter value.
+func ParseTxMessagesFilter(s string) (TxMessagesFilter, error) {
+	filter := TxMessagesFilter{}
+	if err := json.Unmarshal([]byte(s), &filter); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidTxMessagesFilter, "failed to unmarshal transaction messages filter: %v", err)
+	}
+	if len(filter) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidTxMessagesFilter, "filter must have at least one message filter")
+	}
+
+	for idx, f := range filter {
+		if !strings.HasPrefix(f.TypeUrl, "/") {
+			return nil, sdkerrors.Wrapf(ErrInvalidTxMessagesFilter, "message filter idx=%d is invalid: type url '%s' must start with '/'", idx, f.TypeUrl)
+		}
+		for fieldIdx, c := range f.Fields {
+			if err := c.validate(); err != nil {
+				return nil, sdkerrors.Wrapf(ErrInvalidTxMessagesFilter, "message filter idx=%d field condition idx=%d is invalid: %v", idx, fieldIdx, err)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+// MatchTx checks if any of the messages of the transaction encoded in txBytes matches the filter. The messages
+// are decoded with the interface registry of the codec, messages of the types unknown to it can be matched
+// only by the filter items without field conditions.
+func (f TxMessagesFilter) MatchTx(cdc codec.Codec, txBytes []byte) (bool, error) {
+	// the messages aren't unpacked on decoding, so the messages of unknown types don't fail it
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(txBytes); err != nil {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "failed to decode transaction: %v", err)
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(txRaw.BodyBytes); err != nil {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "failed to decode transaction body: %v", err)
+	}
+
+	for _, anyMsg := range body.Messages {
+		var msgJSON map[string]interface{}
+		for _, msgFilter := range f {
+			if msgFilter.TypeUrl != anyMsg.TypeUrl {
+				continue
+			}
+			if len(msgFilter.Fields) == 0 {
+				return true, nil
+			}
+
+			if msgJSON == nil {
+				var msg sdk.Msg
+				if err := cdc.UnpackAny(anyMsg, &msg); err != nil {
+					// the message fields can't be checked if the message type is unknown
+					break
+				}
+				bz, err := cdc.MarshalJSON(msg)
+				if err != nil {
+					return false, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal message: %v", err)
+				}
+				if err := json.Unmarshal(bz, &msgJSON); err != nil {
+					return false, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unmarshal message: %v", err)
+				}
+			}
+
+			if msgFilter.matchFields(msgJSON) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (f TxMessageFilter) matchFields(msgJSON map[string]interface{}) bool {
+	for _, c := range f.Fields {
+		if !c.match(fieldValues(msgJSON, strings.Split(c.Field, "."))) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c TxMessageFieldCondition) validate() error {
+	if c.Field == "" {
+		return fmt.Errorf("field couldn't be empty")
+	}
+
+	op := strings.ToLower(c.Op)
+	switch op {
+	case "eq", "contains":
+		switch value := c.Value.(type) {
+		case string:
+		case float64:
+			if value != float64(int64(value)) {
+				return fmt.Errorf("value %v can't be a decimal number", value)
+			}
+			if op == "contains" {
+				return fmt.Errorf("value for op '%s' must be a string", c.Op)
+			}
+		default:
+			return fmt.Errorf("value '%v' is expected to be on of: string, int", c.Value)
+		}
+	case "exists":
+		if c.Value != nil {
+			return fmt.Errorf("value must be omitted for op '%s'", c.Op)
+		}
+	default:
+		return fmt.Errorf("op '%s' is expected to be one of: eq, contains, exists", c.Op)
+	}
+
+	return nil
+}
+
+// match checks if any of the values of the field meets the condition.
+func (c TxMessageFieldCondition) match(values []interface{}) bool {
+	op := strings.ToLower(c.Op)
+	if op == "exists" {
+		return len(values) > 0
+	}
+
+	expected, _ := scalarToString(c.Value)
+	for _, value := range values {
+		actual, ok := scalarToString(value)
+		switch {
+		case !ok:
+			continue
+		case op == "eq" && actual == expected:
+			return true
+		case op == "contains" && strings.Contains(actual, expected):
+			return true
+		}
+	}
+	return false
+}
+
+// fieldValues returns the non-null values found by the path in the JSON value, every element of the arrays
+// on the way is followed.
+func fieldValues(value interface{}, path []string) []interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		var values []interface{}
+		for _, elem := range v {
+			values = append(values, fieldValues(elem, path)...)
+		}
+		return values
+	}
+
+	if len(path) == 0 {
+		return []interface{}{value}
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return fieldValues(obj[path[0]], path[1:])
+}
+
+// scalarToString renders JSON scalars the way the protobuf JSON encoding does: 64-bit integers are strings there.
+func scalarToString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatInt(int64(v), 10), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
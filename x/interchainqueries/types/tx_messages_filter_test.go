@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	iqtypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+)
+
+func TestParseTxMessagesFilter(t *testing.T) {
+	_, err := iqtypes.ParseTxMessagesFilter(`[{"type_url":"/cosmos.bank.v1beta1.MsgSend"}]`)
+	require.NoError(t, err)
+	_, err = iqtypes.ParseTxMessagesFilter(`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"addr"},{"field":"amount.denom","op":"contains","value":"atom"},{"field":"amount","op":"exists"}]}]`)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		filter string
+		err    string
+	}{
+		{`{}`, "failed to unmarshal transaction messages filter"},
+		{`[]`, "filter must have at least one message filter"},
+		{`[{"type_url":"cosmos.bank.v1beta1.MsgSend"}]`, "must start with '/'"},
+		{`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"","op":"eq","value":"addr"}]}]`, "field couldn't be empty"},
+		{`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"gt","value":"addr"}]}]`, "op 'gt' is expected to be one of: eq, contains, exists"},
+		{`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":1.5}]}]`, "can't be a decimal number"},
+		{`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"contains","value":1}]}]`, "value for op 'contains' must be a string"},
+		{`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"exists","value":"addr"}]}]`, "value must be omitted for op 'exists'"},
+	}
+	for _, tc := range testCases {
+		_, err := iqtypes.ParseTxMessagesFilter(tc.filter)
+		require.ErrorIs(t, err, iqtypes.ErrInvalidTxMessagesFilter)
+		require.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestTxMessagesFilterMatchTx(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	send := &banktypes.MsgSend{
+		FromAddress: "from",
+		ToAddress:   "to",
+		Amount:      sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("untrn", sdk.NewInt(5))),
+	}
+	multiSend := &banktypes.MsgMultiSend{Outputs: []banktypes.Output{{Address: "to"}}}
+	txBytes := encodeTx(t, cdc, send, multiSend)
+
+	testCases := []struct {
+		name    string
+		filter  string
+		matched bool
+	}{
+		{"type url only", `[{"type_url":"/cosmos.bank.v1beta1.MsgMultiSend"}]`, true},
+		{"other type url", `[{"type_url":"/cosmos.staking.v1beta1.MsgDelegate"}]`, false},
+		{"field eq", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"to"}]}]`, true},
+		{"field not eq", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"from"}]}]`, false},
+		{"all the fields are checked", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"to"},{"field":"from_address","op":"eq","value":"to"}]}]`, false},
+		{"repeated field element eq", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"amount.denom","op":"eq","value":"untrn"}]}]`, true},
+		{"integer as string", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"amount.amount","op":"eq","value":100}]}]`, true},
+		{"contains", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"amount.denom","op":"contains","value":"ato"}]}]`, true},
+		{"exists", `[{"type_url":"/cosmos.bank.v1beta1.MsgMultiSend","fields":[{"field":"outputs.address","op":"exists"}]}]`, true},
+		{"not exists", `[{"type_url":"/cosmos.bank.v1beta1.MsgMultiSend","fields":[{"field":"outputs.memo","op":"exists"}]}]`, false},
+		{"object isn't a scalar", `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"amount","op":"eq","value":""}]}]`, false},
+		{"any of message filters", `[{"type_url":"/cosmos.staking.v1beta1.MsgDelegate"},{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"to"}]}]`, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := iqtypes.ParseTxMessagesFilter(tc.filter)
+			require.NoError(t, err)
+
+			matched, err := filter.MatchTx(cdc, txBytes)
+			require.NoError(t, err)
+			require.Equal(t, tc.matched, matched)
+		})
+	}
+
+	// fields of the messages unknown to the interface registry can't be checked
+	filter, err := iqtypes.ParseTxMessagesFilter(`[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"eq","value":"to"}]}]`)
+	require.NoError(t, err)
+	matched, err := filter.MatchTx(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), txBytes)
+	require.NoError(t, err)
+	require.False(t, matched)
+
+	_, err = filter.MatchTx(cdc, []byte("not a tx"))
+	require.Error(t, err)
+}
+
+func encodeTx(t *testing.T, cdc codec.Codec, msgs ...sdk.Msg) []byte {
+	body := txtypes.TxBody{}
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		body.Messages = append(body.Messages, anyMsg)
+	}
+
+	bodyBz, err := cdc.Marshal(&body)
+	require.NoError(t, err)
+	txBz, err := cdc.Marshal(&txtypes.TxRaw{BodyBytes: bodyBz})
+	require.NoError(t, err)
+
+	return txBz
+}
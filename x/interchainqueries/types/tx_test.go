@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +49,79 @@ func TestMsgRegisterInterchainQueryValidate(t *testing.T) {
 			},
 			iqtypes.ErrInvalidTransactionsFilter,
 		},
+		{
+			"result history for TX query",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId:       "connection-0",
+					TransactionsFilter: "[]",
+					Keys:               nil,
+					QueryType:          string(iqtypes.InterchainQueryTypeTX),
+					UpdatePeriod:       1,
+					Sender:             TestAddress,
+					ResultHistorySize:  10,
+				}
+			},
+			iqtypes.ErrInvalidResultHistorySize,
+		},
+		{
+			"transaction messages filter for KV query",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId:     "connection-0",
+					Keys:             []*iqtypes.KVKey{{Path: "path", Key: []byte("key")}},
+					QueryType:        string(iqtypes.InterchainQueryTypeKV),
+					UpdatePeriod:     1,
+					Sender:           TestAddress,
+					TxMessagesFilter: `[{"type_url":"/cosmos.bank.v1beta1.MsgSend"}]`,
+				}
+			},
+			iqtypes.ErrInvalidTxMessagesFilter,
+		},
+		{
+			"invalid transaction messages filter",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId:       "connection-0",
+					TransactionsFilter: "[]",
+					QueryType:          string(iqtypes.InterchainQueryTypeTX),
+					UpdatePeriod:       1,
+					Sender:             TestAddress,
+					TxMessagesFilter:   `[{"type_url":"/cosmos.bank.v1beta1.MsgSend","fields":[{"field":"to_address","op":"gt","value":1}]}]`,
+				}
+			},
+			iqtypes.ErrInvalidTxMessagesFilter,
+		},
+		{
+			"too many keys",
+			func() sdktypes.Msg {
+				keys := make([]*iqtypes.KVKey, iqtypes.MaxKVQueryKeysCountLimit+1)
+				for i := range keys {
+					keys[i] = &iqtypes.KVKey{Path: "path", Key: []byte("key")}
+				}
+				return &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId: "connection-0",
+					Keys:         keys,
+					QueryType:    string(iqtypes.InterchainQueryTypeKV),
+					UpdatePeriod: 1,
+					Sender:       TestAddress,
+				}
+			},
+			iqtypes.ErrTooManyKVQueryKeys,
+		},
+		{
+			"too long transactions filter",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRegisterInterchainQuery{
+					ConnectionId:       "connection-0",
+					TransactionsFilter: strings.Repeat(" ", iqtypes.MaxTransactionsFilterLengthLimit) + "[]",
+					QueryType:          string(iqtypes.InterchainQueryTypeTX),
+					UpdatePeriod:       1,
+					Sender:             TestAddress,
+				}
+			},
+			iqtypes.ErrInvalidTransactionsFilter,
+		},
 		{
 			"invalid update period",
 			func() sdktypes.Msg {
@@ -165,6 +239,25 @@ func TestMsgSubmitQueryResultValidate(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"empty block transaction",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResult{
+					QueryId:  1,
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Result: &iqtypes.QueryResult{
+						Block: &iqtypes.Block{
+							Tx:  &iqtypes.TxValue{Data: []byte("tx")},
+							Txs: []*iqtypes.TxValue{nil},
+						},
+						Height:   100,
+						Revision: 1,
+					},
+				}
+			},
+			iqtypes.ErrEmptyResult,
+		},
 		{
 			"empty result",
 			func() sdktypes.Msg {
@@ -333,6 +426,51 @@ func TestMsgUpdateQueryRequestValidate(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"valid new transactions filter and connection",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgUpdateInterchainQueryRequest{
+					QueryId:               1,
+					NewTransactionsFilter: `[{"field":"tx.height","op":"gt","value":10}]`,
+					NewConnectionId:       "connection-1",
+					Sender:                TestAddress,
+				}
+			},
+			nil,
+		},
+		{
+			"invalid new transactions filter",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgUpdateInterchainQueryRequest{
+					QueryId:               1,
+					NewTransactionsFilter: "&)(^Y(*&(*&(&(*",
+					Sender:                TestAddress,
+				}
+			},
+			iqtypes.ErrInvalidTransactionsFilter,
+		},
+		{
+			"blank new connection id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgUpdateInterchainQueryRequest{
+					QueryId:         1,
+					NewConnectionId: "  ",
+					Sender:          TestAddress,
+				}
+			},
+			iqtypes.ErrInvalidConnectionID,
+		},
+		{
+			"too many keys",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgUpdateInterchainQueryRequest{
+					QueryId: 1,
+					NewKeys: make([]*iqtypes.KVKey, iqtypes.MaxKVQueryKeysCountLimit+1),
+					Sender:  TestAddress,
+				}
+			},
+			iqtypes.ErrTooManyKVQueryKeys,
+		},
 		{
 			"empty keys and update_period",
 			func() sdktypes.Msg {
@@ -462,6 +600,287 @@ func TestMsgRemoveQueryRequestValidate(t *testing.T) {
 	}
 }
 
+func TestMsgFundQueryRewardValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		malleate    func() sdktypes.Msg
+		expectedErr error
+	}{
+		{
+			"valid",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgFundQueryReward{
+					QueryId: 1,
+					Amount:  sdktypes.NewCoins(sdktypes.NewCoin("stake", sdktypes.NewInt(100))),
+					Sender:  TestAddress,
+				}
+			},
+			nil,
+		},
+		{
+			"invalid query id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgFundQueryReward{
+					QueryId: 0,
+					Amount:  sdktypes.NewCoins(sdktypes.NewCoin("stake", sdktypes.NewInt(100))),
+					Sender:  TestAddress,
+				}
+			},
+			iqtypes.ErrInvalidQueryID,
+		},
+		{
+			"empty amount",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgFundQueryReward{
+					QueryId: 1,
+					Amount:  sdktypes.NewCoins(),
+					Sender:  TestAddress,
+				}
+			},
+			sdkerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid sender",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgFundQueryReward{
+					QueryId: 1,
+					Amount:  sdktypes.NewCoins(sdktypes.NewCoin("stake", sdktypes.NewInt(100))),
+					Sender:  "invalid-sender",
+				}
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		msg := tt.malleate()
+
+		if tt.expectedErr != nil {
+			require.ErrorIs(t, msg.ValidateBasic(), tt.expectedErr)
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
+
+func TestMsgSubmitQueryResultsValidate(t *testing.T) {
+	kvResults := []*iqtypes.StorageValue{{
+		Key:           []byte{10},
+		Proof:         &crypto.ProofOps{Ops: []crypto.ProofOp{{Type: "type", Key: []byte{10}, Data: []byte{10}}}},
+		Value:         []byte{10},
+		StoragePrefix: host.StoreKey,
+	}}
+
+	tests := []struct {
+		name        string
+		malleate    func() sdktypes.Msg
+		expectedErr error
+	}{
+		{
+			"valid",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Revision: 1,
+					Height:   100,
+					Results: []iqtypes.KVQueryResult{
+						{QueryId: 1, KvResults: kvResults},
+						{QueryId: 2, KvResults: kvResults, AllowKvCallbacks: true},
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"empty results",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Height:   100,
+				}
+			},
+			iqtypes.ErrEmptyResult,
+		},
+		{
+			"empty KV results of a query",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Height:   100,
+					Results:  []iqtypes.KVQueryResult{{QueryId: 1}},
+				}
+			},
+			iqtypes.ErrEmptyResult,
+		},
+		{
+			"invalid query id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Height:   100,
+					Results:  []iqtypes.KVQueryResult{{QueryId: 0, KvResults: kvResults}},
+				}
+			},
+			iqtypes.ErrInvalidQueryID,
+		},
+		{
+			"duplicated query id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   TestAddress,
+					ClientId: "client-id",
+					Height:   100,
+					Results: []iqtypes.KVQueryResult{
+						{QueryId: 1, KvResults: kvResults},
+						{QueryId: 1, KvResults: kvResults},
+					},
+				}
+			},
+			iqtypes.ErrInvalidQueryID,
+		},
+		{
+			"invalid sender",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:   "invalid-sender",
+					ClientId: "client-id",
+					Height:   100,
+					Results:  []iqtypes.KVQueryResult{{QueryId: 1, KvResults: kvResults}},
+				}
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"empty client id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgSubmitQueryResults{
+					Sender:  TestAddress,
+					Height:  100,
+					Results: []iqtypes.KVQueryResult{{QueryId: 1, KvResults: kvResults}},
+				}
+			},
+			iqtypes.ErrInvalidClientID,
+		},
+	}
+
+	for _, tt := range tests {
+		msg := tt.malleate()
+
+		if tt.expectedErr != nil {
+			require.ErrorIs(t, msg.ValidateBasic(), tt.expectedErr)
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
+
+func TestMsgRetrySudoFailureValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		malleate    func() sdktypes.Msg
+		expectedErr error
+	}{
+		{
+			"valid",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRetrySudoFailure{QueryId: 1, FailureId: 1, Sender: TestAddress}
+			},
+			nil,
+		},
+		{
+			"invalid query id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRetrySudoFailure{QueryId: 0, FailureId: 1, Sender: TestAddress}
+			},
+			iqtypes.ErrInvalidQueryID,
+		},
+		{
+			"invalid failure id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRetrySudoFailure{QueryId: 1, FailureId: 0, Sender: TestAddress}
+			},
+			iqtypes.ErrInvalidSudoFailure,
+		},
+		{
+			"invalid sender",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgRetrySudoFailure{QueryId: 1, FailureId: 1, Sender: "invalid-sender"}
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		msg := tt.malleate()
+
+		if tt.expectedErr != nil {
+			require.ErrorIs(t, msg.ValidateBasic(), tt.expectedErr)
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
+
+func TestMsgTransferInterchainQueryOwnershipValidate(t *testing.T) {
+	newOwner := sdktypes.AccAddress([]byte("new_owner_address___")).String()
+
+	tests := []struct {
+		name        string
+		malleate    func() sdktypes.Msg
+		expectedErr error
+	}{
+		{
+			"valid",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgTransferInterchainQueryOwnership{QueryId: 1, NewOwner: newOwner, Sender: TestAddress}
+			},
+			nil,
+		},
+		{
+			"invalid query id",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgTransferInterchainQueryOwnership{QueryId: 0, NewOwner: newOwner, Sender: TestAddress}
+			},
+			iqtypes.ErrInvalidQueryID,
+		},
+		{
+			"invalid new owner",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgTransferInterchainQueryOwnership{QueryId: 1, NewOwner: "invalid-owner", Sender: TestAddress}
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid sender",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgTransferInterchainQueryOwnership{QueryId: 1, NewOwner: newOwner, Sender: "invalid-sender"}
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"new owner is the sender",
+			func() sdktypes.Msg {
+				return &iqtypes.MsgTransferInterchainQueryOwnership{QueryId: 1, NewOwner: TestAddress, Sender: TestAddress}
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		msg := tt.malleate()
+
+		if tt.expectedErr != nil {
+			require.ErrorIs(t, msg.ValidateBasic(), tt.expectedErr)
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
+
 func TestMsgRegisterInterchainQueryGetSigners(t *testing.T) {
 	tests := []struct {
 		name     string
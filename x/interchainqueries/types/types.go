@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -37,6 +38,38 @@ const (
 	// of an interchain query.
 	AttributeTransactionsFilterQuery = "tx_filter"
 
+	// AttributeKeyRelayer represents the key for event attribute delivering the address of the
+	// relayer which submitted a query result.
+	AttributeKeyRelayer = "relayer"
+
+	// AttributeKeySubmissionReward represents the key for event attribute delivering the amount of
+	// coins paid to a relayer for a submitted query result.
+	AttributeKeySubmissionReward = "submission_reward"
+
+	// AttributeKeyRewardEscrow represents the key for event attribute delivering the amount of
+	// coins left in the reward escrow of an interchain query.
+	AttributeKeyRewardEscrow = "reward_escrow"
+
+	// AttributeKeyFailureID represents the key for event attribute delivering the id of a recorded
+	// sudo failure.
+	AttributeKeyFailureID = "failure_id"
+
+	// AttributeKeyContract represents the key for event attribute delivering the address of the
+	// contract a query result was passed to.
+	AttributeKeyContract = "contract"
+
+	// AttributeKeyClientID represents the key for event attribute delivering the IBC client ID
+	// of an interchain query connection.
+	AttributeKeyClientID = "client_id"
+
+	// AttributeKeyClientStatus represents the key for event attribute delivering the status of the
+	// IBC client of an interchain query connection.
+	AttributeKeyClientStatus = "client_status"
+
+	// AttributeKeyPreviousOwner represents the key for event attribute delivering the address of the
+	// previous owner of an interchain query.
+	AttributeKeyPreviousOwner = "previous_owner"
+
 	// AttributeValueCategory represents the value for the 'module' event attribute.
 	AttributeValueCategory = ModuleName
 
@@ -45,6 +78,30 @@ const (
 
 	// AttributeValueQueryRemoved represents the value for the 'action' event attribute.
 	AttributeValueQueryRemoved = "query_removed"
+
+	// AttributeValueRewardFunded represents the value for the 'action' event attribute.
+	AttributeValueRewardFunded = "reward_funded"
+
+	// AttributeValueRelayerRewarded represents the value for the 'action' event attribute.
+	AttributeValueRelayerRewarded = "relayer_rewarded"
+
+	// AttributeValueRewardEscrowOutOfFunds represents the value for the 'action' event attribute.
+	AttributeValueRewardEscrowOutOfFunds = "reward_escrow_out_of_funds"
+
+	// AttributeValueSudoFailed represents the value for the 'action' event attribute.
+	AttributeValueSudoFailed = "sudo_failed"
+
+	// AttributeValueSudoFailureRetried represents the value for the 'action' event attribute.
+	AttributeValueSudoFailureRetried = "sudo_failure_retried"
+
+	// AttributeValueQueryOwnershipTransferred represents the value for the 'action' event attribute.
+	AttributeValueQueryOwnershipTransferred = "query_ownership_transferred"
+
+	// AttributeValueQuerySuspended represents the value for the 'action' event attribute.
+	AttributeValueQuerySuspended = "query_suspended"
+
+	// AttributeValueQueryResumed represents the value for the 'action' event attribute.
+	AttributeValueQueryResumed = "query_resumed"
 )
 
 const (
@@ -69,6 +126,15 @@ func (icqt InterchainQueryType) IsTX() bool {
 	return icqt == InterchainQueryTypeTX
 }
 
+// Transactions returns all the transactions submitted within the block: tx followed by txs.
+func (b *Block) Transactions() []*TxValue {
+	txs := make([]*TxValue, 0, len(b.GetTxs())+1)
+	if b.GetTx() != nil {
+		txs = append(txs, b.GetTx())
+	}
+	return append(txs, b.GetTxs()...)
+}
+
 func (kv KVKey) ToString() string {
 	return kv.Path + kvPathKeyDelimiter + hex.EncodeToString(kv.Key)
 }
@@ -123,53 +189,207 @@ func (keys KVKeys) String() string {
 }
 
 // TransactionsFilter represents the model of transactions filter parameter used in interchain
-// queries of type TX.
+// queries of type TX. All the items of the filter must be met by a transaction.
 type TransactionsFilter []TransactionsFilterItem
 
-// TransactionsFilterItem is a single condition for filtering transactions in search.
+// TransactionsFilterItem is either a single condition for filtering transactions in search or an OR
+// group of filters.
 type TransactionsFilterItem struct {
 	// Field is the field used in condition, e.g. tx.height or transfer.recipient.
-	Field string `json:"field"`
-	// Op is the operation for filtering, one of the following: eq, gt, gte, lt, lte.
-	Op string `json:"op"`
-	// Value is the value for comparison.
-	Value interface{} `json:"value"`
+	Field string `json:"field,omitempty"`
+	// Op is the operation for filtering, one of the following: eq, gt, gte, lt, lte, contains, exists.
+	Op string `json:"op,omitempty"`
+	// Value is the value for comparison. It must be omitted for the exists operation.
+	Value interface{} `json:"value,omitempty"`
+	// Or is the list of filters at least one of which must be met by a transaction. The item with
+	// the OR group must not have a condition set.
+	Or []TransactionsFilter `json:"or,omitempty"`
+}
+
+// maxTransactionsFilterSearches is the max amount of Tendermint searches a transactions filter is rendered to.
+// Tendermint search doesn't support OR, so every combination of the OR groups members takes a separate search.
+const maxTransactionsFilterSearches = 16
+
+// ParseTransactionsFilter unmarshals and validates the passed TransactionsFilter value.
+func ParseTransactionsFilter(s string) (TransactionsFilter, error) {
+	filter := TransactionsFilter{}
+	if err := json.Unmarshal([]byte(s), &filter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal transactions filter: %w", err)
+	}
+	if err := filter.validate(""); err != nil {
+		return nil, err
+	}
+	if searches := filter.searchesCount(); searches > maxTransactionsFilterSearches {
+		return nil, fmt.Errorf("transactions filter is rendered to more than %d tendermint searches", maxTransactionsFilterSearches)
+	}
+	return filter, nil
 }
 
 // ValidateTransactionsFilter checks if the passed string is a valid TransactionsFilter value.
 func ValidateTransactionsFilter(s string) error {
-	filters := TransactionsFilter{}
-	if err := json.Unmarshal([]byte(s), &filters); err != nil {
-		return fmt.Errorf("failed to unmarshal transactions filter: %w", err)
-	}
-	for idx, f := range filters {
-		for _, r := range forbiddenRunes {
-			if strings.ContainsRune(f.Field, r) {
-				return fmt.Errorf("transactions filter condition idx=%d is invalid: special symbols %v are not allowed", idx, forbiddenRunesAsStr())
+	_, err := ParseTransactionsFilter(s)
+	return err
+}
+
+// TendermintQueries renders the filter into the Tendermint tx search queries a relayer must run. A transaction
+// meets the filter if it is found by any of the queries. The conditions of a query are joined with AND, the
+// filter without conditions is rendered to a single empty query. The filter is expected to be valid.
+func (f TransactionsFilter) TendermintQueries() []string {
+	conjunctions := f.conjunctions()
+	queries := make([]string, 0, len(conjunctions))
+	for _, conditions := range conjunctions {
+		queries = append(queries, strings.Join(conditions, " AND "))
+	}
+	return queries
+}
+
+// conjunctions returns the lists of the rendered conditions, one list per Tendermint search.
+func (f TransactionsFilter) conjunctions() [][]string {
+	conjunctions := [][]string{{}}
+	for _, item := range f {
+		if len(item.Or) == 0 {
+			for i := range conjunctions {
+				conjunctions[i] = append(conjunctions[i], item.condition())
+			}
+			continue
+		}
+
+		expanded := make([][]string, 0, len(conjunctions))
+		for _, conditions := range conjunctions {
+			for _, group := range item.Or {
+				for _, groupConditions := range group.conjunctions() {
+					combined := make([]string, 0, len(conditions)+len(groupConditions))
+					combined = append(combined, conditions...)
+					expanded = append(expanded, append(combined, groupConditions...))
+				}
 			}
 		}
-		if f.Field == "" {
-			return fmt.Errorf("transactions filter condition idx=%d is invalid: field couldn't be empty", idx)
+		conjunctions = expanded
+	}
+	return conjunctions
+}
+
+// searchesCount returns the amount of Tendermint searches the filter is rendered to without rendering it.
+func (f TransactionsFilter) searchesCount() int {
+	count := 1
+	for _, item := range f {
+		if len(item.Or) == 0 {
+			continue
+		}
+
+		groupsCount := 0
+		for _, group := range item.Or {
+			groupsCount += group.searchesCount()
 		}
-		switch value := f.Value.(type) {
-		case string:
-		case float64:
-			// despite json turns numbers into float, decimals are not allowed by tendermint API
-			if value != float64(int64(value)) {
-				return fmt.Errorf("transactions filter condition idx=%d is invalid: value %v can't be a decimal number", idx, value)
+		count *= groupsCount
+		if count > maxTransactionsFilterSearches {
+			return count
+		}
+	}
+	return count
+}
+
+func (f TransactionsFilter) validate(path string) error {
+	for idx, item := range f {
+		itemPath := path + strconv.Itoa(idx)
+		if len(item.Or) > 0 {
+			if item.Field != "" || item.Op != "" || item.Value != nil {
+				return fmt.Errorf("transactions filter condition idx=%s is invalid: or group can't have field, op or value", itemPath)
 			}
-		default:
-			return fmt.Errorf("transactions filter condition idx=%d is invalid: value '%v' is expected to be on of: string, float, int", idx, f.Value)
+			for groupIdx, group := range item.Or {
+				if len(group) == 0 {
+					return fmt.Errorf("transactions filter condition idx=%s is invalid: or group %d can't be empty", itemPath, groupIdx)
+				}
+				if err := group.validate(fmt.Sprintf("%s.or.%d.", itemPath, groupIdx)); err != nil {
+					return err
+				}
+			}
+			continue
 		}
-		switch strings.ToLower(f.Op) {
-		case "eq", "gt", "gte", "lt", "lte":
-		default:
-			return fmt.Errorf("transactions filter condition idx=%d is invalid: op '%s' is expected to be one of: eq, gt, gte, lt, lte", idx, f.Op)
+		if err := item.validateCondition(itemPath); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func (item TransactionsFilterItem) validateCondition(idx string) error {
+	for _, r := range forbiddenRunes {
+		if strings.ContainsRune(item.Field, r) {
+			return fmt.Errorf("transactions filter condition idx=%s is invalid: special symbols %v are not allowed", idx, forbiddenRunesAsStr())
+		}
+	}
+	if item.Field == "" {
+		return fmt.Errorf("transactions filter condition idx=%s is invalid: field couldn't be empty", idx)
+	}
+
+	op := strings.ToLower(item.Op)
+	switch op {
+	case "eq", "gt", "gte", "lt", "lte", "contains", "exists":
+	default:
+		return fmt.Errorf("transactions filter condition idx=%s is invalid: op '%s' is expected to be one of: eq, gt, gte, lt, lte, contains, exists", idx, item.Op)
+	}
+
+	switch value := item.Value.(type) {
+	case nil:
+		if op != "exists" {
+			return fmt.Errorf("transactions filter condition idx=%s is invalid: value is required for op '%s'", idx, item.Op)
+		}
+	case string:
+		// the value is rendered in single quotes and tendermint search supports neither quotes in values
+		// nor escaping. The stored filters with quoted values are rewritten by the v3 store migration.
+		if strings.ContainsAny(value, `'"`) {
+			return fmt.Errorf("transactions filter condition idx=%s is invalid: value can't contain quotes", idx)
+		}
+	case float64:
+		// despite json turns numbers into float, decimals are not allowed by tendermint API
+		if value != float64(int64(value)) {
+			return fmt.Errorf("transactions filter condition idx=%s is invalid: value %v can't be a decimal number", idx, value)
+		}
+		if op == "contains" {
+			return fmt.Errorf("transactions filter condition idx=%s is invalid: value for op '%s' must be a string", idx, item.Op)
+		}
+	default:
+		return fmt.Errorf("transactions filter condition idx=%s is invalid: value '%v' is expected to be on of: string, float, int", idx, item.Value)
+	}
+	if op == "exists" && item.Value != nil {
+		return fmt.Errorf("transactions filter condition idx=%s is invalid: value must be omitted for op '%s'", idx, item.Op)
+	}
+
+	return nil
+}
+
+// condition renders the condition item in Tendermint query syntax.
+func (item TransactionsFilterItem) condition() string {
+	var operator string
+	switch strings.ToLower(item.Op) {
+	case "exists":
+		return item.Field + " EXISTS"
+	case "eq":
+		operator = "="
+	case "gt":
+		operator = ">"
+	case "gte":
+		operator = ">="
+	case "lt":
+		operator = "<"
+	case "lte":
+		operator = "<="
+	case "contains":
+		operator = "CONTAINS"
+	}
+
+	var value string
+	switch v := item.Value.(type) {
+	case string:
+		value = "'" + v + "'"
+	case float64:
+		value = strconv.FormatInt(int64(v), 10)
+	}
+
+	return item.Field + " " + operator + " " + value
+}
+
 var forbiddenRunes = []rune{'\t', '\n', '\r', '\\', '(', ')', '"', '\'', '=', '>', '<'}
 
 func forbiddenRunesAsStr() []string {
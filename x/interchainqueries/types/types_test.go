@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTransactionFilterValidation(t *testing.T) {
@@ -16,6 +17,10 @@ func TestTransactionFilterValidation(t *testing.T) {
 		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Gte","value":1000}]`))
 		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Lt","value":1000}]`))
 		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Lte","value":1000}]`))
+		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"message.action","op":"Contains","value":"Delegate"}]`))
+		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"transfer.recipient","op":"Exists"}]`))
+		// or group
+		assert.NoError(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Gte","value":100},{"or":[[{"field":"transfer.recipient","op":"Eq","value":"a"}],[{"field":"transfer.sender","op":"Eq","value":"a"}]]}]`))
 	})
 	t.Run("Invalid", func(t *testing.T) {
 		// invalid json
@@ -38,5 +43,44 @@ func TestTransactionFilterValidation(t *testing.T) {
 		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"transfer.<","op":"Eq","value":"neutron1mjk79fjjgpplak5wq838w0yd982gzkyf8fxu8u"}]`), "special symbols [\t \n \r \\ ( ) \" ' = > <] are not allowed")
 		// decimal number
 		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Gte","value":15.5}]`), "can't be a decimal number")
+		// unsupported value types for the operations
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Contains","value":15}]`), "value for op 'Contains' must be a string")
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"transfer.recipient","op":"Exists","value":"a"}]`), "value must be omitted for op 'Exists'")
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"transfer.recipient","op":"Eq"}]`), "value is required for op 'Eq'")
+		// quotes can't be used in tendermint search values
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"transfer.recipient","op":"Eq","value":"a' OR tx.height > '0"}]`), "value can't contain quotes")
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"wasm.action","op":"Eq","value":"say \"hi\""}]`), "value can't contain quotes")
+		// invalid or groups
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"field":"tx.height","op":"Eq","value":1,"or":[[{"field":"tx.height","op":"Eq","value":2}]]}]`), "or group can't have field, op or value")
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"or":[[]]}]`), "idx=0 is invalid: or group 0 can't be empty")
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[{"or":[[{"field":"tx.height","op":"Eq","value":1}],[{"field":"","op":"Eq","value":1}]]}]`), "idx=0.or.1.0 is invalid: field couldn't be empty")
+		// too many combinations of the or groups members: 9 searches are fine, 27 are not
+		group := `{"or":[[{"field":"a.b","op":"Exists"}],[{"field":"b.c","op":"Exists"}],[{"field":"c.d","op":"Exists"}]]}`
+		assert.NoError(t, ValidateTransactionsFilter(`[`+group+`,`+group+`]`))
+		assert.ErrorContains(t, ValidateTransactionsFilter(`[`+group+`,`+group+`,`+group+`]`), "is rendered to more than 16 tendermint searches")
 	})
 }
+
+func TestTransactionsFilterTendermintQueries(t *testing.T) {
+	filter, err := ParseTransactionsFilter(`[
+		{"field":"tx.height","op":"Gte","value":100},
+		{"field":"message.action","op":"contains","value":"Delegate"},
+		{"or":[
+			[{"field":"transfer.recipient","op":"eq","value":"addr"}],
+			[{"field":"transfer.sender","op":"eq","value":"addr"},{"field":"transfer.amount","op":"exists"}]
+		]}
+	]`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"tx.height >= 100 AND message.action CONTAINS 'Delegate' AND transfer.recipient = 'addr'",
+		"tx.height >= 100 AND message.action CONTAINS 'Delegate' AND transfer.sender = 'addr' AND transfer.amount EXISTS",
+	}, filter.TendermintQueries())
+
+	filter, err = ParseTransactionsFilter(`[{"field":"tx.height","op":"Lt","value":5},{"field":"tx.height","op":"Gt","value":1},{"field":"tx.height","op":"lte","value":4}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tx.height < 5 AND tx.height > 1 AND tx.height <= 4"}, filter.TendermintQueries())
+
+	filter, err = ParseTransactionsFilter(`[]`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, filter.TendermintQueries())
+}